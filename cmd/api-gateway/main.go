@@ -6,10 +6,17 @@ import (
 	"log/slog"
 
 	"github.com/notification-system-moxicom/persistence-service/internal/config"
+	"github.com/notification-system-moxicom/persistence-service/internal/http/handlers"
 	"github.com/notification-system-moxicom/persistence-service/internal/kafka"
+	"github.com/notification-system-moxicom/persistence-service/internal/kafka/handler/incoming"
+	"github.com/notification-system-moxicom/persistence-service/internal/ratelimit"
 	"github.com/notification-system-moxicom/persistence-service/internal/repository"
 	"github.com/notification-system-moxicom/persistence-service/internal/rpc"
+	"github.com/notification-system-moxicom/persistence-service/internal/scheduler"
+	"github.com/notification-system-moxicom/persistence-service/internal/server"
+	"github.com/notification-system-moxicom/persistence-service/internal/unsubscribe"
 	"github.com/notification-system-moxicom/persistence-service/internal/validation"
+	"github.com/notification-system-moxicom/persistence-service/internal/watch"
 	"github.com/notification-system-moxicom/persistence-service/pkg/logger"
 )
 
@@ -28,6 +35,7 @@ func main() {
 
 	schemaFiles := map[string]string{
 		"notification_message": "schemas/send_notification.json",
+		"DeliveryResult":       "schemas/delivery_result.json",
 	}
 
 	validator, err := validation.NewJSONSchemaMessageValidator(schemaFiles)
@@ -45,14 +53,64 @@ func main() {
 		return
 	}
 
-	_, err = kafka.NewService(&cfg.Connections.Kafka.CamundaCore, validator)
+	kafkaService, err := kafka.NewService(&cfg.Connections.Kafka.CamundaCore, validator)
 	if err != nil {
 		slog.Error("failed to create Kafka service:", slog.String("error", err.Error()))
 
 		return
 	}
 
-	rpcServ := rpc.NewGRPC(&cfg.Server.GRPC, repo)
+	outboxRelay := kafka.NewOutboxRelay(
+		kafkaService,
+		repo,
+		cfg.Connections.Kafka.CamundaCore.Outbox,
+		map[string]string{
+			repository.EventNotificationCreated:   cfg.Settings.OperationsTopics["send_notification_topic"],
+			repository.EventNotificationCancelled: cfg.Settings.OperationsTopics["cancel_notification_topic"],
+			repository.EventQuotaWarning:          cfg.Settings.OperationsTopics["quota_warning_topic"],
+		},
+	)
+
+	go outboxRelay.Run(ctx)
+
+	kafkaService.StartConsumer(
+		ctx,
+		kafka.DeliveryResultsConsumer,
+		[]string{cfg.Settings.OperationsTopics["delivery_results_topic"]},
+		incoming.NewDeliveryResultHandler(validator, repo),
+		cfg.Connections.Kafka.CamundaCore.ConsumerWorkersCount,
+	)
+
+	notificationScheduler := scheduler.New(repo, cfg.Settings.Scheduler)
+
+	go notificationScheduler.Run(ctx)
+
+	eventHub := watch.NewHub(repo, cfg.Settings.Watch)
+
+	go eventHub.Run(ctx)
+
+	rateLimiter, err := ratelimit.New(cfg.Server.GRPC.RateLimit, repo)
+	if err != nil {
+		slog.Error("failed to create rate limiter:", slog.String("error", err.Error()))
+		return
+	}
+
+	unsubscribeSigner, err := unsubscribe.New(cfg.Settings.Unsubscribe)
+	if err != nil {
+		slog.Error("failed to create unsubscribe signer:", slog.String("error", err.Error()))
+		return
+	}
+
+	httpServ := server.NewServer(handlers.NewHandlers(unsubscribeSigner, repo))
+	httpServ.AddHTTPServer(cfg.Server.HTTP)
+
+	go func() {
+		if err := httpServ.Listen(); err != nil {
+			slog.Error("failed to listen HTTP server", slog.String("error", err.Error()))
+		}
+	}()
+
+	rpcServ := rpc.NewGRPC(&cfg.Server.GRPC, repo, eventHub, rateLimiter, unsubscribeSigner)
 	if err = rpcServ.Listen(); err != nil {
 		slog.Error("failed to listen RPC server", slog.String("error", err.Error()))
 		return
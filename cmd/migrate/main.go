@@ -10,11 +10,13 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"time"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
 
 	"github.com/notification-system-moxicom/persistence-service/internal/config"
+	"github.com/notification-system-moxicom/persistence-service/internal/partitions"
 	"github.com/notification-system-moxicom/persistence-service/internal/repository"
 )
 
@@ -26,7 +28,7 @@ func main() {
 
 func run() error {
 	var (
-		command    = flag.String("command", "up", "Migration command: up, down, status, or version")
+		command    = flag.String("command", "up", "Migration command: up, down, status, version, or partitions")
 		dir        = flag.String("dir", "migrations", "Directory with migration files")
 		configPath = flag.String("config", "configs/config.yaml", "Path to config file")
 		version    = flag.Int64("version", 0, "Version for up-to or down-to commands")
@@ -59,6 +61,10 @@ func run() error {
 		return err
 	}
 
+	if *command == "partitions" {
+		return runPartitions(db, cfg.Connections.Postgres.Partitions)
+	}
+
 	return executeCommand(db, migrationsDir, *command, *version)
 }
 
@@ -110,7 +116,10 @@ func executeCommand(db *sql.DB, migrationsDir, command string, version int64) er
 	case "create":
 		return runCreate(db, migrationsDir)
 	default:
-		return fmt.Errorf("unknown command: %s. Available commands: up, down, down-to, up-to, status, version, create", command)
+		return fmt.Errorf(
+			"unknown command: %s. Available commands: up, down, down-to, up-to, status, version, create, partitions",
+			command,
+		)
 	}
 }
 
@@ -195,3 +204,13 @@ func runCreate(db *sql.DB, migrationsDir string) error {
 
 	return nil
 }
+
+func runPartitions(db *sql.DB, cfg partitions.Config) error {
+	if err := partitions.NewManager(db, cfg).Maintain(context.Background(), time.Now()); err != nil {
+		return fmt.Errorf("failed to maintain partitions: %w", err)
+	}
+
+	log.Println("Partitions maintained successfully")
+
+	return nil
+}
@@ -8,7 +8,10 @@ import (
 	"github.com/notification-system-moxicom/persistence-service/internal/kafka"
 	"github.com/notification-system-moxicom/persistence-service/internal/repository"
 	"github.com/notification-system-moxicom/persistence-service/internal/rpc"
+	"github.com/notification-system-moxicom/persistence-service/internal/scheduler"
 	"github.com/notification-system-moxicom/persistence-service/internal/server"
+	"github.com/notification-system-moxicom/persistence-service/internal/unsubscribe"
+	"github.com/notification-system-moxicom/persistence-service/internal/watch"
 )
 
 type Config struct {
@@ -30,8 +33,11 @@ type Config struct {
 }
 
 type SettingsConfig struct {
-	ContractVersion  string            `yaml:"contract_version"`
-	OperationsTopics map[string]string `yaml:"operations_topics"`
+	ContractVersion  string             `yaml:"contract_version"`
+	OperationsTopics map[string]string  `yaml:"operations_topics"`
+	Scheduler        scheduler.Config   `yaml:"scheduler"`
+	Watch            watch.Config       `yaml:"watch"`
+	Unsubscribe      unsubscribe.Config `yaml:"unsubscribe"`
 }
 
 type Integrations struct {
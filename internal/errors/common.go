@@ -77,3 +77,117 @@ func (e ValidationError) Is(err error) bool {
 
 	return ok
 }
+
+type NotFoundError struct {
+	msg     string
+	details []string
+}
+
+func NewNotFoundError(msg string, details ...string) NotFoundError {
+	return NotFoundError{msg: msg, details: details}
+}
+
+func (e NotFoundError) Error() string {
+	return e.msg
+}
+
+func (e NotFoundError) Details() []string {
+	return e.details
+}
+
+func (e NotFoundError) Is(err error) bool {
+	var notFoundError NotFoundError
+
+	ok := errors.As(err, &notFoundError)
+
+	return ok
+}
+
+type ConflictError struct {
+	msg     string
+	details []string
+}
+
+func NewConflictError(msg string, details ...string) ConflictError {
+	return ConflictError{msg: msg, details: details}
+}
+
+func (e ConflictError) Error() string {
+	return e.msg
+}
+
+func (e ConflictError) Details() []string {
+	return e.details
+}
+
+func (e ConflictError) Is(err error) bool {
+	var conflictError ConflictError
+
+	ok := errors.As(err, &conflictError)
+
+	return ok
+}
+
+// InvalidTransitionError reports a status change the lifecycle of an entity
+// does not allow, e.g. a sent notification moving back to pending.
+type InvalidTransitionError struct {
+	entity string
+	id     string
+	from   string
+	to     string
+}
+
+func NewInvalidTransitionError(entity, id, from, to string) InvalidTransitionError {
+	return InvalidTransitionError{entity: entity, id: id, from: from, to: to}
+}
+
+func (e InvalidTransitionError) Error() string {
+	return fmt.Sprintf("%s cannot move from %s to %s", e.entity, e.from, e.to)
+}
+
+func (e InvalidTransitionError) Details() []string {
+	return []string{fmt.Sprintf("%s %s is %s", e.entity, e.id, e.from)}
+}
+
+func (e InvalidTransitionError) From() string {
+	return e.from
+}
+
+func (e InvalidTransitionError) To() string {
+	return e.to
+}
+
+func (e InvalidTransitionError) Is(err error) bool {
+	var invalidTransitionError InvalidTransitionError
+
+	ok := errors.As(err, &invalidTransitionError)
+
+	return ok
+}
+
+// QuotaExceededError reports a system that has used up its contracted volume
+// for the current period.
+type QuotaExceededError struct {
+	msg     string
+	details []string
+}
+
+func NewQuotaExceededError(msg string, details ...string) QuotaExceededError {
+	return QuotaExceededError{msg: msg, details: details}
+}
+
+func (e QuotaExceededError) Error() string {
+	return e.msg
+}
+
+func (e QuotaExceededError) Details() []string {
+	return e.details
+}
+
+func (e QuotaExceededError) Is(err error) bool {
+	var quotaExceededError QuotaExceededError
+
+	ok := errors.As(err, &quotaExceededError)
+
+	return ok
+}
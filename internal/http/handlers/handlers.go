@@ -1,11 +1,21 @@
 package handlers
 
-import "net/http"
+import (
+	"net/http"
 
-type Handlers struct{}
+	"github.com/notification-system-moxicom/persistence-service/internal/unsubscribe"
+)
 
-func NewHandlers() *Handlers {
-	return &Handlers{}
+type Handlers struct {
+	signer       *unsubscribe.Signer
+	unsubscriber Unsubscriber
+}
+
+func NewHandlers(signer *unsubscribe.Signer, unsubscriber Unsubscriber) *Handlers {
+	return &Handlers{
+		signer:       signer,
+		unsubscriber: unsubscriber,
+	}
 }
 
 func (h *Handlers) GetSystems(w http.ResponseWriter, r *http.Request) {
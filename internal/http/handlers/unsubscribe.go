@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/notification-system-moxicom/persistence-service/api"
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	"github.com/notification-system-moxicom/persistence-service/internal/unsubscribe"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+type Unsubscriber interface {
+	Unsubscribe(ctx context.Context, systemID, userID string, channel rpcv1.Channel, category string) error
+}
+
+// Unsubscribe redeems the token passed in the token query or form parameter
+// and records the opt-out. It serves one-click unsubscribe (RFC 8058) POSTs.
+func (h *Handlers) Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	claims, err := h.signer.Verify(r.FormValue("token"), time.Now())
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, unsubscribe.ErrExpiredToken) {
+			status = http.StatusGone
+		}
+
+		writeError(w, status, err.Error())
+
+		return
+	}
+
+	err = h.unsubscriber.Unsubscribe(r.Context(), claims.SystemID, claims.UserID, claims.Channel, claims.Category)
+	if err != nil {
+		var notFound apperrors.NotFoundError
+		if errors.As(err, &notFound) {
+			writeError(w, http.StatusNotFound, notFound.Error())
+			return
+		}
+
+		slog.Error("unsubscribe failed", "user_id", claims.UserID, "error", err)
+		writeError(w, http.StatusInternalServerError, "failed to unsubscribe")
+
+		return
+	}
+
+	message := "unsubscribed"
+	writeJSON(w, http.StatusOK, api.InfoMessage{Message: &message})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, api.ErrorResponse{Error: &message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		slog.Error("write response failed", "error", err)
+	}
+}
@@ -2,9 +2,14 @@ package kafka
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"sync"
+	"time"
 
 	"github.com/IBM/sarama"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
 )
 
 const (
@@ -14,11 +19,13 @@ const (
 type ConsumerGroupHandler struct {
 	handler      MessageHandler
 	workersCount int
+	retry        RetryConfig
 }
 
 func NewConsumerHandler(
 	messageHandler MessageHandler,
 	workers int,
+	retry RetryConfig,
 ) *ConsumerGroupHandler {
 	if workers <= 0 {
 		workers = defaultWorkersCount
@@ -27,6 +34,7 @@ func NewConsumerHandler(
 	return &ConsumerGroupHandler{
 		handler:      messageHandler,
 		workersCount: workers,
+		retry:        retry,
 	}
 }
 
@@ -34,21 +42,24 @@ func (cgh *ConsumerGroupHandler) Setup(_ sarama.ConsumerGroupSession) error { re
 
 func (cgh *ConsumerGroupHandler) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
 
+// ConsumeClaim handles up to workersCount messages of the claim at a time.
+// Messages are marked in offset order only, see offsetTracker.
 func (cgh *ConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	sem := make(chan struct{}, cgh.workersCount)
+	tracker := &offsetTracker{}
 
 	for msg := range claim.Messages() {
 		sem <- struct{}{}
 
-		go func(m *sarama.ConsumerMessage) {
+		tracked := tracker.track(msg)
+
+		go func(m *trackedMessage) {
 			defer func() { <-sem }()
 
-			if err := cgh.handler.HandleMessage(context.Background(), m); err != nil {
-				slog.Error("failed to handle message", slog.Any("error", err))
+			if cgh.handle(session.Context(), m.msg) {
+				tracker.done(session, m)
 			}
-
-			session.MarkMessage(m, "")
-		}(msg)
+		}(tracked)
 	}
 
 	for i := 0; i < cap(sem); i++ {
@@ -57,3 +68,92 @@ func (cgh *ConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSessio
 
 	return nil
 }
+
+// offsetTracker marks the messages of a claim in offset order. sarama commits
+// the highest marked offset, so a message is only marked once every earlier
+// message of the partition is done with, the rest waits for it.
+type offsetTracker struct {
+	mu      sync.Mutex
+	pending []*trackedMessage // in offset order
+}
+
+type trackedMessage struct {
+	msg  *sarama.ConsumerMessage
+	done bool
+}
+
+// track registers a message, in the order the claim delivers them.
+func (t *offsetTracker) track(msg *sarama.ConsumerMessage) *trackedMessage {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	m := &trackedMessage{msg: msg}
+	t.pending = append(t.pending, m)
+
+	return m
+}
+
+// done records that the message is done with and marks the longest prefix of
+// pending messages that are all done.
+func (t *offsetTracker) done(session sarama.ConsumerGroupSession, m *trackedMessage) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	m.done = true
+
+	var last *sarama.ConsumerMessage
+
+	for len(t.pending) > 0 && t.pending[0].done {
+		last = t.pending[0].msg
+		t.pending[0] = nil
+		t.pending = t.pending[1:]
+	}
+
+	if last != nil {
+		session.MarkMessage(last, "")
+	}
+}
+
+// handle processes the message and reports whether it is done with. Invalid
+// messages are logged and dropped, since redelivery cannot fix them. Any other
+// failure, such as an unavailable database, is retried with backoff until it
+// succeeds or the session ends; the message is then left unmarked, and so are
+// the later messages of its partition, so they are all consumed again after
+// the rebalance.
+func (cgh *ConsumerGroupHandler) handle(ctx context.Context, msg *sarama.ConsumerMessage) bool {
+	for attempt := 0; ; attempt++ {
+		err := cgh.handler.HandleMessage(ctx, msg)
+		if err == nil {
+			return true
+		}
+
+		var invalidErr apperrors.InvalidMessageError
+		if errors.As(err, &invalidErr) {
+			slog.Error(
+				"dropping invalid message",
+				slog.String("topic", msg.Topic),
+				slog.Int64("offset", msg.Offset),
+				slog.Any("error", err),
+			)
+
+			return true
+		}
+
+		backoff := calculateBackoff(attempt, cgh.retry.InitialBackoff, cgh.retry.MaxBackoff, cgh.retry.BackoffMultiplier)
+
+		slog.Warn(
+			"failed to handle message, retrying",
+			slog.String("topic", msg.Topic),
+			slog.Int64("offset", msg.Offset),
+			slog.Int("attempt", attempt+1),
+			slog.Duration("backoff", backoff),
+			slog.Any("error", err),
+		)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
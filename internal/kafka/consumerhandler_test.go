@@ -0,0 +1,77 @@
+package kafka
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/IBM/sarama"
+)
+
+// markingSession records the offsets marked on it.
+type markingSession struct {
+	sarama.ConsumerGroupSession
+	marked []int64
+}
+
+func (s *markingSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+func TestOffsetTrackerMarksContiguousPrefix(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		messages   int
+		doneOrder  []int
+		wantMarked []int64
+	}{
+		{
+			name:       "in order",
+			messages:   3,
+			doneOrder:  []int{0, 1, 2},
+			wantMarked: []int64{0, 1, 2},
+		},
+		{
+			name:       "later message waits for earlier one",
+			messages:   3,
+			doneOrder:  []int{2, 1, 0},
+			wantMarked: []int64{2},
+		},
+		{
+			name:       "unfinished message blocks later ones",
+			messages:   3,
+			doneOrder:  []int{0, 2},
+			wantMarked: []int64{0},
+		},
+		{
+			name:      "nothing done",
+			messages:  2,
+			doneOrder: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				session = &markingSession{}
+				tracker = &offsetTracker{}
+				tracked []*trackedMessage
+			)
+
+			for i := range tt.messages {
+				tracked = append(tracked, tracker.track(&sarama.ConsumerMessage{Offset: int64(i)}))
+			}
+
+			for _, i := range tt.doneOrder {
+				tracker.done(session, tracked[i])
+			}
+
+			if !slices.Equal(session.marked, tt.wantMarked) {
+				t.Errorf("marked offsets = %v, want %v", session.marked, tt.wantMarked)
+			}
+		})
+	}
+}
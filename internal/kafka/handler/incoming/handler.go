@@ -1,3 +1,97 @@
 package incoming
 
-type Handler struct{}
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	"github.com/notification-system-moxicom/persistence-service/internal/kafka/message"
+	"github.com/notification-system-moxicom/persistence-service/internal/validation"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+type DeliveryResultStore interface {
+	UpdateRecipientStatus(
+		ctx context.Context,
+		notificationID string,
+		notificationCreatedAt time.Time,
+		userID string,
+		channel rpcv1.Channel,
+		status rpcv1.DeliveryStatus,
+		lastError string,
+	) (*rpcv1.RecipientDelivery, error)
+}
+
+// DeliveryResultHandler records delivery outcomes reported by dispatchers.
+type DeliveryResultHandler struct {
+	validator *validation.JSONSchemaMessageValidator
+	store     DeliveryResultStore
+}
+
+func NewDeliveryResultHandler(
+	validator *validation.JSONSchemaMessageValidator,
+	store DeliveryResultStore,
+) *DeliveryResultHandler {
+	return &DeliveryResultHandler{
+		validator: validator,
+		store:     store,
+	}
+}
+
+func (h *DeliveryResultHandler) HandleMessage(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var result message.DeliveryResult
+
+	if err := h.validator.ValidateJSON(&result, msg.Value); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(msg.Value, &result); err != nil {
+		return apperrors.NewInvalidMessageError("failed to unmarshal delivery result", err)
+	}
+
+	delivery, err := h.store.UpdateRecipientStatus(
+		ctx,
+		result.NotificationID,
+		result.NotificationCreatedAt,
+		result.UserID,
+		rpcv1.Channel(rpcv1.Channel_value["CHANNEL_"+strings.ToUpper(result.Channel)]),
+		rpcv1.DeliveryStatus(rpcv1.DeliveryStatus_value["DELIVERY_STATUS_"+strings.ToUpper(result.Status)]),
+		result.Error,
+	)
+	if err != nil {
+		err = fmt.Errorf(
+			"failed to record delivery result of notification %s for user %s: %w",
+			result.NotificationID, result.UserID, err,
+		)
+
+		// Results for unknown recipients or stale statuses never succeed, so
+		// they are rejected like malformed messages instead of being retried.
+		var (
+			notFoundErr   apperrors.NotFoundError
+			transitionErr apperrors.InvalidTransitionError
+		)
+
+		if errors.As(err, &notFoundErr) || errors.As(err, &transitionErr) {
+			return apperrors.NewInvalidMessageError("delivery result rejected", err)
+		}
+
+		return err
+	}
+
+	slog.Debug(
+		"delivery result recorded",
+		slog.String("notification_id", result.NotificationID),
+		slog.String("recipient_id", delivery.GetRecipientId()),
+		slog.String("channel", result.Channel),
+		slog.String("status", result.Status),
+	)
+
+	return nil
+}
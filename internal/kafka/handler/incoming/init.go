@@ -1,5 +1,2 @@
+// Package incoming handles messages consumed from Kafka.
 package incoming
-
-//
-// Can add later to support incoming messages by kafka
-//
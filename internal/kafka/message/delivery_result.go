@@ -0,0 +1,18 @@
+package message
+
+import "time"
+
+// DeliveryResult is reported by dispatchers for every delivery attempt of a
+// notification recipient on a channel.
+type DeliveryResult struct {
+	NotificationID string `json:"notification_id"`
+	UserID         string `json:"user_id"`
+	Channel        string `json:"channel"` // email, sms, telegram
+	Status         string `json:"status"`  // queued, sending, delivered, failed, bounced
+	Error          string `json:"error,omitempty"`
+
+	// NotificationCreatedAt is the created_at of the notification.created
+	// event, echoed back so the notification is found without a lookup across
+	// partitions. Optional.
+	NotificationCreatedAt time.Time `json:"notification_created_at,omitzero"`
+}
@@ -0,0 +1,124 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/notification-system-moxicom/persistence-service/internal/repository"
+	"github.com/notification-system-moxicom/persistence-service/pkg/util/generic"
+)
+
+const (
+	defaultOutboxPollInterval       = time.Second
+	defaultOutboxBatchSize          = 100
+	defaultOutboxPublishedRetention = 24 * time.Hour
+)
+
+type OutboxConfig struct {
+	PollInterval       time.Duration `yaml:"poll_interval"`
+	BatchSize          int           `yaml:"batch_size"`
+	PublishedRetention time.Duration `yaml:"published_retention"`
+}
+
+type OutboxStore interface {
+	ProcessOutbox(ctx context.Context, limit int, publish repository.PublishFunc, backoff repository.BackoffFunc) (int, error)
+	DeletePublishedOutboxEvents(ctx context.Context, olderThan time.Time) (int64, error)
+}
+
+// OutboxRelay publishes events stored in the transactional outbox to Kafka.
+// Delivery is at-least-once: an event is marked as published only after the
+// broker has acknowledged it.
+type OutboxRelay struct {
+	service *Service
+	store   OutboxStore
+	config  OutboxConfig
+	topics  map[string]string // event type -> topic
+}
+
+func NewOutboxRelay(
+	service *Service,
+	store OutboxStore,
+	cfg OutboxConfig,
+	topics map[string]string,
+) *OutboxRelay {
+	cfg.PollInterval = generic.DefaultIfZero(cfg.PollInterval, defaultOutboxPollInterval)
+	cfg.BatchSize = generic.DefaultIfZero(cfg.BatchSize, defaultOutboxBatchSize)
+	cfg.PublishedRetention = generic.DefaultIfZero(cfg.PublishedRetention, defaultOutboxPublishedRetention)
+
+	return &OutboxRelay{
+		service: service,
+		store:   store,
+		config:  cfg,
+		topics:  topics,
+	}
+}
+
+// Run polls the outbox until ctx is canceled.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	slog.Info("outbox relay started", slog.Duration("poll_interval", r.config.PollInterval))
+
+	for {
+		r.drain(ctx)
+
+		deleted, err := r.store.DeletePublishedOutboxEvents(ctx, time.Now().UTC().Add(-r.config.PublishedRetention))
+		if err != nil {
+			slog.Error("failed to delete published outbox events", slog.Any("error", err))
+		} else if deleted > 0 {
+			slog.Debug("deleted published outbox events", slog.Int64("count", deleted))
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("context canceled, stopping outbox relay")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain keeps processing batches while the outbox returns full ones.
+func (r *OutboxRelay) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		processed, err := r.store.ProcessOutbox(ctx, r.config.BatchSize, r.publish, r.backoff)
+		if err != nil {
+			slog.Error("failed to process outbox", slog.Any("error", err))
+			return
+		}
+
+		if processed < r.config.BatchSize {
+			return
+		}
+	}
+}
+
+func (r *OutboxRelay) publish(_ context.Context, event repository.OutboxEvent) error {
+	topic, ok := r.topics[event.EventType]
+	if !ok || topic == "" {
+		return fmt.Errorf("no topic configured for event type %s", event.EventType)
+	}
+
+	if err := r.service.Produce(topic, json.RawMessage(event.Payload)); err != nil {
+		slog.Warn(
+			"failed to publish outbox event",
+			slog.Int64("id", event.ID),
+			slog.String("event_type", event.EventType),
+			slog.Any("error", err),
+		)
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *OutboxRelay) backoff(attempt int) time.Duration {
+	retry := r.service.serviceConfig.Retry
+
+	return calculateBackoff(attempt-1, retry.InitialBackoff, retry.MaxBackoff, retry.BackoffMultiplier)
+}
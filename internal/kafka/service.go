@@ -41,6 +41,7 @@ const (
 	DelegatesResponseConsumer   = "delegates-response-consumer"
 	CreateUserTaskConsumer      = "create-user-task-consumer"
 	GroupLoginsResponseConsumer = "group-logins-response-consumer"
+	DeliveryResultsConsumer     = "delivery-results-consumer"
 )
 
 type Config struct {
@@ -54,6 +55,7 @@ type Config struct {
 	ProducerFlushMessages  int           `yaml:"producer_flush_messages"`
 	ProducerFlushFrequency time.Duration `yaml:"producer_flush_frequency"`
 	Auth                   AuthConfig    `yaml:"auth"`
+	Outbox                 OutboxConfig  `yaml:"outbox"`
 }
 
 type AuthConfig struct {
@@ -131,24 +133,14 @@ func NewService(
 		}
 	}
 
-	consumers := make(map[string]sarama.ConsumerGroup)
-	//nolint //its ok
-	// EXAMPLE OF ADDING CONSUMER GROUP
-	//
-	// startProcessConsumer, err := sarama.NewConsumerGroup(cfg.Brokers, cfg.ConsumerGroup, saramaCfg)
-	// if err != nil {
-	//	return nil, fmt.Errorf("failed to create consumer group: %w", err)
-	//}
-	//
-	// createServiceTaskConsumer, err := sarama.NewConsumerGroup(
-	//	cfg.Brokers,
-	//	cfg.ConsumerGroup,
-	//	saramaCfg,
-	//)
-	// if err != nil {
-	//	return nil, fmt.Errorf("failed to create consumer group: %w", err)
-	//}
-	// consumers[StartProcessConsumer] = startProcessConsumer
+	deliveryResultsConsumer, err := sarama.NewConsumerGroup(cfg.Brokers, cfg.ConsumerGroup, saramaCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consumer group: %w", err)
+	}
+
+	consumers := map[string]sarama.ConsumerGroup{
+		DeliveryResultsConsumer: deliveryResultsConsumer,
+	}
 
 	return &Service{
 		serviceConfig: cfg,
@@ -309,7 +301,7 @@ func (s *Service) StartConsumer(
 				// Continue execution
 			}
 
-			consumerHandler := NewConsumerHandler(handler, workersCount)
+			consumerHandler := NewConsumerHandler(handler, workersCount, s.serviceConfig.Retry)
 			err := cg.Consume(ctx, topics, consumerHandler)
 
 			// Check if service is being closed or context is done
@@ -0,0 +1,388 @@
+package partitions
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/notification-system-moxicom/persistence-service/pkg/util/generic"
+)
+
+const (
+	defaultPremakeMonths = 3
+
+	notificationsTable = "notifications"
+	recipientsTable    = "notification_recipients"
+
+	// Monthly partitions are named <table>_pYYYY_MM and cover that month in UTC.
+	// Rows outside of every monthly partition land in <table>_default.
+	partitionSuffixLayout  = "2006_01"
+	defaultPartitionSuffix = "_default"
+	partitionBoundLayout   = "2006-01-02 15:04:05-07"
+)
+
+type Config struct {
+	// PremakeMonths is how many months ahead of the current one get a partition.
+	PremakeMonths int `yaml:"premake_months"`
+	// RetentionMonths is how many months before the current one are kept
+	// attached. Zero keeps every partition.
+	RetentionMonths int `yaml:"retention_months"`
+	// Drop drops retired partitions instead of only detaching them.
+	Drop bool `yaml:"drop"`
+}
+
+// Manager maintains the monthly partitions of notifications and
+// notification_recipients.
+type Manager struct {
+	db     *sql.DB
+	config Config
+}
+
+func NewManager(db *sql.DB, cfg Config) *Manager {
+	cfg.PremakeMonths = generic.DefaultIfZero(cfg.PremakeMonths, defaultPremakeMonths)
+
+	return &Manager{
+		db:     db,
+		config: cfg,
+	}
+}
+
+// Maintain creates the partitions up to PremakeMonths after the month of now
+// and retires the ones that ended more than RetentionMonths before it.
+func (m *Manager) Maintain(ctx context.Context, now time.Time) error {
+	current := monthStart(now)
+
+	for i := 0; i <= m.config.PremakeMonths; i++ {
+		if err := m.createPartitions(ctx, current.AddDate(0, i, 0)); err != nil {
+			return err
+		}
+	}
+
+	if m.config.RetentionMonths <= 0 {
+		return nil
+	}
+
+	return m.retirePartitions(ctx, current.AddDate(0, -m.config.RetentionMonths, 0))
+}
+
+// createPartitions creates the partitions of the month. Rows of the month
+// written while it had no partition sit in the default partitions and would
+// make creating the partition fail, so the partitions are created as plain
+// tables, the rows are moved into them and they are attached afterwards, all
+// in one transaction.
+func (m *Manager) createPartitions(ctx context.Context, month time.Time) (err error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	missing := make(map[string]bool)
+
+	for _, table := range []string{notificationsTable, recipientsTable} {
+		var exists bool
+
+		name := partitionName(table, month)
+
+		if err = tx.QueryRowContext(
+			ctx, "SELECT to_regclass($1) IS NOT NULL", pgx.Identifier{name}.Sanitize(),
+		).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to look up partition %s: %w", name, err)
+		}
+
+		missing[table] = !exists
+	}
+
+	// Recipients are moved first: deleting notifications from the default
+	// partition cascades to the recipients still in there.
+	for _, table := range []string{recipientsTable, notificationsTable} {
+		if !missing[table] {
+			continue
+		}
+
+		if err = createPartitionTable(ctx, tx, table, month); err != nil {
+			return err
+		}
+	}
+
+	// Notifications are attached first: attaching recipients validates their
+	// foreign key to notifications.
+	for _, table := range []string{notificationsTable, recipientsTable} {
+		if !missing[table] {
+			continue
+		}
+
+		name := partitionName(table, month)
+
+		attachQuery := fmt.Sprintf(
+			"ALTER TABLE %s ATTACH PARTITION %s FOR VALUES FROM ('%s') TO ('%s')",
+			pgx.Identifier{table}.Sanitize(),
+			pgx.Identifier{name}.Sanitize(),
+			month.Format(partitionBoundLayout),
+			month.AddDate(0, 1, 0).Format(partitionBoundLayout),
+		)
+
+		if _, err = tx.ExecContext(ctx, attachQuery); err != nil {
+			return fmt.Errorf("failed to attach partition %s: %w", name, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	for _, table := range []string{notificationsTable, recipientsTable} {
+		if missing[table] {
+			slog.Info("partition created", slog.String("partition", partitionName(table, month)))
+		}
+	}
+
+	return nil
+}
+
+// createPartitionTable creates the partition of the month as a plain table and
+// moves the rows of the month from the default partition into it. The rows are
+// deleted from the default partition directly, so no trigger of the parent
+// table sees them leave.
+func createPartitionTable(ctx context.Context, tx *sql.Tx, table string, month time.Time) error {
+	name := partitionName(table, month)
+
+	createQuery := fmt.Sprintf(
+		"CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING CONSTRAINTS)",
+		pgx.Identifier{name}.Sanitize(),
+		pgx.Identifier{table}.Sanitize(),
+	)
+
+	if _, err := tx.ExecContext(ctx, createQuery); err != nil {
+		return fmt.Errorf("failed to create partition %s: %w", name, err)
+	}
+
+	moveQuery := fmt.Sprintf(
+		`WITH moved AS (
+			DELETE FROM %s WHERE created_at >= $1 AND created_at < $2 RETURNING *
+		)
+		INSERT INTO %s SELECT * FROM moved`,
+		pgx.Identifier{table + defaultPartitionSuffix}.Sanitize(),
+		pgx.Identifier{name}.Sanitize(),
+	)
+
+	result, err := tx.ExecContext(ctx, moveQuery, month, month.AddDate(0, 1, 0))
+	if err != nil {
+		return fmt.Errorf("failed to move rows of %s out of the default partition: %w", name, err)
+	}
+
+	moved, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows moved into %s: %w", name, err)
+	}
+
+	if moved > 0 {
+		slog.Warn(
+			"rows moved out of the default partition",
+			slog.String("partition", name),
+			slog.Int64("rows", moved),
+		)
+	}
+
+	return nil
+}
+
+// retirePartitions detaches, and drops if configured, the partitions of the
+// months before cutoff. Recipients go first, they reference notifications.
+// notification_deliveries and digest_items have no foreign key to the
+// partitioned recipients, so their rows of a dropped recipients partition are
+// deleted along with it.
+func (m *Manager) retirePartitions(ctx context.Context, cutoff time.Time) error {
+	for _, table := range []string{recipientsTable, notificationsTable} {
+		months, err := m.listPartitions(ctx, table)
+		if err != nil {
+			return err
+		}
+
+		for _, month := range months {
+			if !month.Before(cutoff) {
+				continue
+			}
+
+			if err := m.retirePartition(ctx, table, partitionName(table, month)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) retirePartition(ctx context.Context, table, name string) (err error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if m.config.Drop && table == recipientsTable {
+		deleteQuery := fmt.Sprintf(
+			"DELETE FROM notification_deliveries d USING %s r WHERE d.recipient_id = r.id",
+			pgx.Identifier{name}.Sanitize(),
+		)
+
+		if _, err = tx.ExecContext(ctx, deleteQuery); err != nil {
+			return fmt.Errorf("failed to delete deliveries of partition %s: %w", name, err)
+		}
+
+		deleteQuery = fmt.Sprintf(
+			"DELETE FROM digest_items i USING %s r WHERE i.recipient_id = r.id",
+			pgx.Identifier{name}.Sanitize(),
+		)
+
+		if _, err = tx.ExecContext(ctx, deleteQuery); err != nil {
+			return fmt.Errorf("failed to delete digest items of partition %s: %w", name, err)
+		}
+	}
+
+	detachQuery := fmt.Sprintf(
+		"ALTER TABLE %s DETACH PARTITION %s",
+		pgx.Identifier{table}.Sanitize(),
+		pgx.Identifier{name}.Sanitize(),
+	)
+
+	if _, err = tx.ExecContext(ctx, detachQuery); err != nil {
+		return fmt.Errorf("failed to detach partition %s: %w", name, err)
+	}
+
+	// A detached recipients partition keeps its foreign key to notifications,
+	// which would keep the notifications partition of the month from being
+	// detached.
+	if table == recipientsTable {
+		if err = dropNotificationForeignKeys(ctx, tx, name); err != nil {
+			return err
+		}
+	}
+
+	if m.config.Drop {
+		if _, err = tx.ExecContext(ctx, "DROP TABLE "+pgx.Identifier{name}.Sanitize()); err != nil {
+			return fmt.Errorf("failed to drop partition %s: %w", name, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	slog.Info("partition retired", slog.String("partition", name), slog.Bool("dropped", m.config.Drop))
+
+	return nil
+}
+
+// dropNotificationForeignKeys drops the foreign keys from the table to
+// notifications.
+func dropNotificationForeignKeys(ctx context.Context, tx *sql.Tx, name string) error {
+	const query = `SELECT conname
+		FROM pg_constraint
+		WHERE conrelid = $1::regclass AND contype = 'f' AND confrelid = $2::regclass`
+
+	rows, err := tx.QueryContext(ctx, query, pgx.Identifier{name}.Sanitize(), notificationsTable)
+	if err != nil {
+		return fmt.Errorf("failed to list foreign keys of %s: %w", name, err)
+	}
+
+	var constraints []string
+
+	for rows.Next() {
+		var constraint string
+		if err = rows.Scan(&constraint); err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("failed to scan foreign key name: %w", err)
+		}
+
+		constraints = append(constraints, constraint)
+	}
+
+	_ = rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate foreign keys of %s: %w", name, err)
+	}
+
+	for _, constraint := range constraints {
+		dropQuery := fmt.Sprintf(
+			"ALTER TABLE %s DROP CONSTRAINT %s",
+			pgx.Identifier{name}.Sanitize(),
+			pgx.Identifier{constraint}.Sanitize(),
+		)
+
+		if _, err = tx.ExecContext(ctx, dropQuery); err != nil {
+			return fmt.Errorf("failed to drop foreign key %s of %s: %w", constraint, name, err)
+		}
+	}
+
+	return nil
+}
+
+// listPartitions returns the months of the monthly partitions attached to the
+// table. The default partition and partitions named otherwise are skipped.
+func (m *Manager) listPartitions(ctx context.Context, table string) ([]time.Time, error) {
+	const query = `SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		JOIN pg_class p ON p.oid = i.inhparent
+		WHERE p.relname = $1 AND p.relnamespace = current_schema()::regnamespace`
+
+	rows, err := m.db.QueryContext(ctx, query, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partitions of %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	var months []time.Time
+
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan partition name: %w", err)
+		}
+
+		suffix, ok := strings.CutPrefix(name, table+"_p")
+		if !ok {
+			continue
+		}
+
+		month, parseErr := time.Parse(partitionSuffixLayout, suffix)
+		if parseErr != nil {
+			continue
+		}
+
+		months = append(months, month)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate partitions of %s: %w", table, err)
+	}
+
+	return months, nil
+}
+
+func partitionName(table string, month time.Time) string {
+	return table + "_p" + month.Format(partitionSuffixLayout)
+}
+
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,153 @@
+// Package quiethours decides when a notification may reach a user who does not
+// want to be disturbed at certain local times of the day.
+package quiethours
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // timezones must resolve in images without zoneinfo
+)
+
+const clockLayout = "15:04"
+
+// Window is a local time range in HH:MM form. Windows whose end is before
+// their start span midnight.
+type Window struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// Schedule holds the quiet windows of a user in the user's timezone.
+type Schedule struct {
+	location *time.Location
+	windows  []minuteRange
+}
+
+type minuteRange struct {
+	start int
+	end   int
+}
+
+func (m minuteRange) contains(minute int) bool {
+	if m.start < m.end {
+		return minute >= m.start && minute < m.end
+	}
+
+	return minute >= m.start || minute < m.end
+}
+
+// LoadLocation resolves an IANA timezone name, UTC when the name is empty.
+func LoadLocation(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q", timezone)
+	}
+
+	return location, nil
+}
+
+// ValidateWindow reports why the window cannot be used, or nil.
+func ValidateWindow(window Window) error {
+	_, err := parseWindow(window)
+
+	return err
+}
+
+// New builds the schedule of the windows in the given timezone.
+func New(timezone string, windows []Window) (*Schedule, error) {
+	location, err := LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &Schedule{location: location}
+
+	for _, window := range windows {
+		parsed, err := parseWindow(window)
+		if err != nil {
+			return nil, err
+		}
+
+		schedule.windows = append(schedule.windows, parsed)
+	}
+
+	return schedule, nil
+}
+
+// DeliverAfter returns the end of the quiet window at is in and true, or at
+// and false when at is outside every window. Adjacent or overlapping windows
+// are followed until a time outside all of them is reached.
+func (s *Schedule) DeliverAfter(at time.Time) (time.Time, bool) {
+	deferred := false
+	local := at.In(s.location)
+
+	// Every window is left at most once, more iterations mean the windows
+	// cover the whole day and there is no time to defer to.
+	for range len(s.windows) + 1 {
+		window, ok := s.windowAt(local)
+		if !ok {
+			return local.UTC(), deferred
+		}
+
+		local = nextClockTime(local, window.end)
+		deferred = true
+	}
+
+	return at, false
+}
+
+func (s *Schedule) windowAt(local time.Time) (minuteRange, bool) {
+	minute := local.Hour()*60 + local.Minute()
+
+	for _, window := range s.windows {
+		if window.contains(minute) {
+			return window, true
+		}
+	}
+
+	return minuteRange{}, false
+}
+
+// nextClockTime returns the first time after local showing the given minute of
+// the day on the local clock.
+func nextClockTime(local time.Time, minute int) time.Time {
+	year, month, day := local.Date()
+
+	next := time.Date(year, month, day, minute/60, minute%60, 0, 0, local.Location())
+	if !next.After(local) {
+		next = time.Date(year, month, day+1, minute/60, minute%60, 0, 0, local.Location())
+	}
+
+	return next
+}
+
+func parseWindow(window Window) (minuteRange, error) {
+	start, err := parseClock(window.Start)
+	if err != nil {
+		return minuteRange{}, err
+	}
+
+	end, err := parseClock(window.End)
+	if err != nil {
+		return minuteRange{}, err
+	}
+
+	if start == end {
+		return minuteRange{}, fmt.Errorf("window %s-%s is empty", window.Start, window.End)
+	}
+
+	return minuteRange{start: start, end: end}, nil
+}
+
+func parseClock(clock string) (int, error) {
+	parsed, err := time.Parse(clockLayout, clock)
+	if err != nil {
+		return 0, fmt.Errorf("time %q must be in HH:MM form", clock)
+	}
+
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
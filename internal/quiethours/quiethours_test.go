@@ -0,0 +1,149 @@
+package quiethours
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleDeliverAfter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		timezone     string
+		windows      []Window
+		at           time.Time
+		wantAt       time.Time
+		wantDeferred bool
+	}{
+		{
+			name:         "outside every window",
+			windows:      []Window{{Start: "22:00", End: "07:00"}},
+			at:           time.Date(2025, 1, 28, 12, 0, 0, 0, time.UTC),
+			wantAt:       time.Date(2025, 1, 28, 12, 0, 0, 0, time.UTC),
+			wantDeferred: false,
+		},
+		{
+			name:         "window end is exclusive",
+			windows:      []Window{{Start: "22:00", End: "07:00"}},
+			at:           time.Date(2025, 1, 28, 7, 0, 0, 0, time.UTC),
+			wantAt:       time.Date(2025, 1, 28, 7, 0, 0, 0, time.UTC),
+			wantDeferred: false,
+		},
+		{
+			name:         "window start is inclusive",
+			windows:      []Window{{Start: "22:00", End: "07:00"}},
+			at:           time.Date(2025, 1, 28, 22, 0, 0, 0, time.UTC),
+			wantAt:       time.Date(2025, 1, 29, 7, 0, 0, 0, time.UTC),
+			wantDeferred: true,
+		},
+		{
+			name:         "spanning midnight before midnight",
+			windows:      []Window{{Start: "22:00", End: "07:00"}},
+			at:           time.Date(2025, 1, 28, 23, 30, 0, 0, time.UTC),
+			wantAt:       time.Date(2025, 1, 29, 7, 0, 0, 0, time.UTC),
+			wantDeferred: true,
+		},
+		{
+			name:         "spanning midnight after midnight",
+			windows:      []Window{{Start: "22:00", End: "07:00"}},
+			at:           time.Date(2025, 1, 28, 6, 59, 0, 0, time.UTC),
+			wantAt:       time.Date(2025, 1, 28, 7, 0, 0, 0, time.UTC),
+			wantDeferred: true,
+		},
+		{
+			name:         "same day window",
+			windows:      []Window{{Start: "12:00", End: "13:30"}},
+			at:           time.Date(2025, 1, 28, 12, 15, 0, 0, time.UTC),
+			wantAt:       time.Date(2025, 1, 28, 13, 30, 0, 0, time.UTC),
+			wantDeferred: true,
+		},
+		{
+			name:         "adjacent windows are followed",
+			windows:      []Window{{Start: "22:00", End: "00:00"}, {Start: "00:00", End: "07:00"}},
+			at:           time.Date(2025, 1, 28, 23, 0, 0, 0, time.UTC),
+			wantAt:       time.Date(2025, 1, 29, 7, 0, 0, 0, time.UTC),
+			wantDeferred: true,
+		},
+		{
+			name:         "overlapping windows are followed",
+			windows:      []Window{{Start: "21:00", End: "23:00"}, {Start: "22:30", End: "06:00"}},
+			at:           time.Date(2025, 1, 28, 21, 30, 0, 0, time.UTC),
+			wantAt:       time.Date(2025, 1, 29, 6, 0, 0, 0, time.UTC),
+			wantDeferred: true,
+		},
+		{
+			name:         "windows covering the whole day do not defer",
+			windows:      []Window{{Start: "00:00", End: "12:00"}, {Start: "12:00", End: "00:00"}},
+			at:           time.Date(2025, 1, 28, 10, 0, 0, 0, time.UTC),
+			wantAt:       time.Date(2025, 1, 28, 10, 0, 0, 0, time.UTC),
+			wantDeferred: false,
+		},
+		{
+			name:         "local time of the user",
+			timezone:     "America/New_York",
+			windows:      []Window{{Start: "22:00", End: "07:00"}},
+			at:           time.Date(2025, 1, 28, 4, 0, 0, 0, time.UTC), // 23:00 EST
+			wantAt:       time.Date(2025, 1, 28, 12, 0, 0, 0, time.UTC),
+			wantDeferred: true,
+		},
+		{
+			name:         "clocks moving forward during the window",
+			timezone:     "Europe/Berlin",
+			windows:      []Window{{Start: "22:00", End: "07:00"}},
+			at:           time.Date(2025, 3, 29, 22, 0, 0, 0, time.UTC), // 23:00 CET
+			wantAt:       time.Date(2025, 3, 30, 5, 0, 0, 0, time.UTC),  // 07:00 CEST
+			wantDeferred: true,
+		},
+		{
+			name:         "clocks moving back during the window",
+			timezone:     "Europe/Berlin",
+			windows:      []Window{{Start: "22:00", End: "07:00"}},
+			at:           time.Date(2025, 10, 25, 21, 0, 0, 0, time.UTC), // 23:00 CEST
+			wantAt:       time.Date(2025, 10, 26, 6, 0, 0, 0, time.UTC),  // 07:00 CET
+			wantDeferred: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			schedule, err := New(tt.timezone, tt.windows)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+
+			gotAt, gotDeferred := schedule.DeliverAfter(tt.at)
+			if !gotAt.Equal(tt.wantAt) || gotDeferred != tt.wantDeferred {
+				t.Errorf("DeliverAfter(%s) = %s, %t, want %s, %t",
+					tt.at, gotAt.UTC(), gotDeferred, tt.wantAt, tt.wantDeferred)
+			}
+		})
+	}
+}
+
+func TestNewRejectsInvalidSchedules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		timezone string
+		windows  []Window
+	}{
+		{name: "unknown timezone", timezone: "Mars/Olympus_Mons"},
+		{name: "malformed start", windows: []Window{{Start: "10pm", End: "07:00"}}},
+		{name: "out of range end", windows: []Window{{Start: "22:00", End: "24:00"}}},
+		{name: "empty window", windows: []Window{{Start: "22:00", End: "22:00"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := New(tt.timezone, tt.windows); err == nil {
+				t.Error("New() error = nil, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+// RetryAfterTrailer carries the number of seconds a rejected caller should
+// wait. A failed call has no headers, the value is sent in the trailers.
+const RetryAfterTrailer = "retry-after"
+
+type systemScoped interface {
+	GetSystemId() string
+}
+
+// UserSystems resolves the system of user mutations, which address the user by
+// its id only.
+type UserSystems interface {
+	GetUserSystemID(ctx context.Context, id string) (string, error)
+}
+
+// UnaryServerInterceptor limits calls of the configured methods per system.
+// Calls over the limit fail with codes.ResourceExhausted, the wait is carried
+// by a RetryInfo status detail and the retry-after trailer. Requests whose system is unknown are passed on, the
+// validation of the handler rejects them. A failing limiter lets calls through
+// rather than taking the API down with it.
+func UnaryServerInterceptor(limiter Limiter, users UserSystems, limits map[string]Limit) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		method := path.Base(info.FullMethod)
+
+		limit, ok := limits[method]
+		if !ok || limit.Rate <= 0 {
+			return handler(ctx, req)
+		}
+
+		systemID, err := requestSystemID(ctx, users, req)
+		if err != nil {
+			slog.Error("failed to resolve system for rate limiting", "method", method, "error", err)
+			return handler(ctx, req)
+		}
+
+		if systemID == "" {
+			return handler(ctx, req)
+		}
+
+		allowed, retryAfter, err := limiter.Take(ctx, systemID+":"+method, limit)
+		if err != nil {
+			slog.Error("rate limiter failed, letting the call through", "method", method, "system_id", systemID, "error", err)
+			return handler(ctx, req)
+		}
+
+		if !allowed {
+			seconds := max(1, int64(math.Ceil(retryAfter.Seconds())))
+
+			if err = grpc.SetTrailer(ctx, metadata.Pairs(RetryAfterTrailer, strconv.FormatInt(seconds, 10))); err != nil {
+				slog.Warn("failed to set retry-after trailer", "method", method, "error", err)
+			}
+
+			st := status.New(
+				codes.ResourceExhausted,
+				fmt.Sprintf("rate limit of %s exceeded for system %s, retry in %d seconds", method, systemID, seconds),
+			)
+
+			detailed, detailErr := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Duration(seconds) * time.Second)})
+			if detailErr != nil {
+				slog.Warn("failed to attach retry info", "method", method, "error", detailErr)
+				return nil, st.Err()
+			}
+
+			return nil, detailed.Err()
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func requestSystemID(ctx context.Context, users UserSystems, req any) (string, error) {
+	var systemID string
+
+	switch r := req.(type) {
+	case systemScoped:
+		systemID = r.GetSystemId()
+	case *rpcv1.UpdateUserRequest:
+		if _, err := uuid.Parse(r.GetId()); err != nil {
+			return "", nil
+		}
+
+		return users.GetUserSystemID(ctx, r.GetId())
+	case *rpcv1.DeleteUserRequest:
+		if _, err := uuid.Parse(r.GetId()); err != nil {
+			return "", nil
+		}
+
+		return users.GetUserSystemID(ctx, r.GetId())
+	}
+
+	if _, err := uuid.Parse(systemID); err != nil {
+		return "", nil
+	}
+
+	return systemID, nil
+}
@@ -0,0 +1,142 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	StoragePostgres = "postgres"
+	StorageMemory   = "memory"
+)
+
+type Config struct {
+	// Storage is where buckets are kept: postgres shares them between
+	// replicas, memory keeps them per instance. Defaults to postgres.
+	Storage string `yaml:"storage"`
+	// Limits maps RPC method names, e.g. Notify, to the limit every system has
+	// for that method. Methods that are not listed are not limited.
+	Limits map[string]Limit `yaml:"limits"`
+}
+
+// Limit is a token bucket refilled at Rate tokens per second and holding up to
+// Burst tokens. Every call takes one token.
+type Limit struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"` // defaults to one second worth of tokens
+}
+
+func (l Limit) capacity() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+
+	return max(1, int(math.Ceil(l.Rate)))
+}
+
+// Limiter takes tokens from the bucket stored under a key. When the bucket is
+// empty, it reports how long until the next token is available.
+type Limiter interface {
+	Take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error)
+}
+
+type Store interface {
+	TakeRateLimitToken(ctx context.Context, key string, rate float64, burst int) (bool, time.Duration, error)
+}
+
+// New returns the limiter for the configured storage. store is only used by
+// the postgres storage.
+func New(cfg Config, store Store) (Limiter, error) {
+	switch cfg.Storage {
+	case "", StoragePostgres:
+		return &storeLimiter{store: store}, nil
+	case StorageMemory:
+		return NewMemoryLimiter(), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit storage %q", cfg.Storage)
+	}
+}
+
+// storeLimiter keeps buckets in a Store shared by every replica.
+type storeLimiter struct {
+	store Store
+}
+
+func (l *storeLimiter) Take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	return l.store.TakeRateLimitToken(ctx, key, limit.Rate, limit.capacity())
+}
+
+// sweepInterval is how often MemoryLimiter looks for buckets to evict.
+const sweepInterval = time.Minute
+
+// MemoryLimiter keeps buckets in process memory. Limits only hold per
+// instance, so it suits single instance deployments. A bucket that has refilled
+// completely is no different from a new one, such buckets are evicted every
+// sweepInterval, so idle keys do not pile up.
+type MemoryLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	sweptAt time.Time
+	now     func() time.Time
+}
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	fullAt    time.Time // when the bucket has refilled completely
+}
+
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (l *MemoryLimiter) Take(_ context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	capacity := float64(limit.capacity())
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.sweptAt) >= sweepInterval {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, updatedAt: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.updatedAt).Seconds()*limit.Rate)
+	b.updatedAt = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+
+	b.fullAt = now.Add(time.Duration((capacity - b.tokens) / limit.Rate * float64(time.Second)))
+
+	if allowed {
+		return true, 0, nil
+	}
+
+	return false, time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second)), nil
+}
+
+// sweep evicts the buckets that have refilled completely by now.
+func (l *MemoryLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if !b.fullAt.After(now) {
+			delete(l.buckets, key)
+		}
+	}
+
+	l.sweptAt = now
+}
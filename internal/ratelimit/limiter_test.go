@@ -0,0 +1,181 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryLimiterTake(t *testing.T) {
+	t.Parallel()
+
+	type take struct {
+		after          time.Duration // clock advance before the take
+		key            string
+		wantAllowed    bool
+		wantRetryAfter time.Duration
+	}
+
+	tests := []struct {
+		name  string
+		limit Limit
+		takes []take
+	}{
+		{
+			name:  "burst is available at once",
+			limit: Limit{Rate: 1, Burst: 3},
+			takes: []take{
+				{key: "a", wantAllowed: true},
+				{key: "a", wantAllowed: true},
+				{key: "a", wantAllowed: true},
+				{key: "a", wantAllowed: false, wantRetryAfter: time.Second},
+			},
+		},
+		{
+			name:  "burst defaults to one second of tokens",
+			limit: Limit{Rate: 2},
+			takes: []take{
+				{key: "a", wantAllowed: true},
+				{key: "a", wantAllowed: true},
+				{key: "a", wantAllowed: false, wantRetryAfter: 500 * time.Millisecond},
+			},
+		},
+		{
+			name:  "fractional rate holds one token",
+			limit: Limit{Rate: 0.5},
+			takes: []take{
+				{key: "a", wantAllowed: true},
+				{key: "a", wantAllowed: false, wantRetryAfter: 2 * time.Second},
+			},
+		},
+		{
+			name:  "retry after shrinks while refilling",
+			limit: Limit{Rate: 1, Burst: 1},
+			takes: []take{
+				{key: "a", wantAllowed: true},
+				{after: 250 * time.Millisecond, key: "a", wantAllowed: false, wantRetryAfter: 750 * time.Millisecond},
+				{after: 750 * time.Millisecond, key: "a", wantAllowed: true},
+			},
+		},
+		{
+			name:  "refill is capped at the burst",
+			limit: Limit{Rate: 10, Burst: 2},
+			takes: []take{
+				{key: "a", wantAllowed: true},
+				{key: "a", wantAllowed: true},
+				{after: time.Hour, key: "a", wantAllowed: true},
+				{key: "a", wantAllowed: true},
+				{key: "a", wantAllowed: false, wantRetryAfter: 100 * time.Millisecond},
+			},
+		},
+		{
+			name:  "keys have separate buckets",
+			limit: Limit{Rate: 1, Burst: 1},
+			takes: []take{
+				{key: "a", wantAllowed: true},
+				{key: "a", wantAllowed: false, wantRetryAfter: time.Second},
+				{key: "b", wantAllowed: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Date(2025, 1, 28, 0, 0, 0, 0, time.UTC)
+
+			limiter := NewMemoryLimiter()
+			limiter.now = func() time.Time { return now }
+
+			for i, step := range tt.takes {
+				now = now.Add(step.after)
+
+				allowed, retryAfter, err := limiter.Take(context.Background(), step.key, tt.limit)
+				if err != nil {
+					t.Fatalf("take %d: Take() error = %v", i, err)
+				}
+
+				if allowed != step.wantAllowed || retryAfter != step.wantRetryAfter {
+					t.Errorf("take %d: Take() = %t, %s, want %t, %s",
+						i, allowed, retryAfter, step.wantAllowed, step.wantRetryAfter)
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryLimiterEvictsRefilledBuckets(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		limit     Limit
+		takes     int
+		after     time.Duration
+		wantKept  bool
+		wantAllow bool
+	}{
+		{
+			name:      "refilled bucket is evicted",
+			limit:     Limit{Rate: 1, Burst: 2},
+			takes:     2,
+			after:     sweepInterval,
+			wantKept:  false,
+			wantAllow: true,
+		},
+		{
+			name:      "refilling bucket is kept",
+			limit:     Limit{Rate: 0.01, Burst: 2},
+			takes:     2,
+			after:     sweepInterval,
+			wantKept:  true,
+			wantAllow: false,
+		},
+		{
+			name:      "no sweep before the interval",
+			limit:     Limit{Rate: 100, Burst: 2},
+			takes:     1,
+			after:     sweepInterval / 2,
+			wantKept:  true,
+			wantAllow: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Date(2025, 1, 28, 0, 0, 0, 0, time.UTC)
+
+			limiter := NewMemoryLimiter()
+			limiter.now = func() time.Time { return now }
+
+			for range tt.takes {
+				if _, _, err := limiter.Take(context.Background(), "a", tt.limit); err != nil {
+					t.Fatalf("Take() error = %v", err)
+				}
+			}
+
+			now = now.Add(tt.after)
+
+			// A take on another key runs the sweep.
+			if _, _, err := limiter.Take(context.Background(), "b", tt.limit); err != nil {
+				t.Fatalf("Take() error = %v", err)
+			}
+
+			if _, kept := limiter.buckets["a"]; kept != tt.wantKept {
+				t.Errorf("bucket kept = %t, want %t", kept, tt.wantKept)
+			}
+
+			allowed, _, err := limiter.Take(context.Background(), "a", tt.limit)
+			if err != nil {
+				t.Fatalf("Take() error = %v", err)
+			}
+
+			if allowed != tt.wantAllow {
+				t.Errorf("Take() after sweep = %t, want %t", allowed, tt.wantAllow)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+// marshalAttributes encodes user attributes for the attributes JSONB column.
+// Missing attributes are stored as an empty object.
+func marshalAttributes(attributes map[string]string) ([]byte, error) {
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+
+	return json.Marshal(attributes)
+}
+
+func unmarshalAttributes(raw []byte) (map[string]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var attributes map[string]string
+	if err := json.Unmarshal(raw, &attributes); err != nil {
+		return nil, err
+	}
+
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+
+	return attributes, nil
+}
+
+// attributeFilterToSql compiles the filter into a condition on the attributes
+// column of the given table alias. Equality checks are expressed with
+// containment, so they are served by the GIN index on users.attributes.
+func attributeFilterToSql(alias string, filter *rpcv1.AttributeFilter) (sq.Sqlizer, error) {
+	column := alias + ".attributes"
+	and := sq.And{}
+
+	for _, condition := range filter.GetConditions() {
+		key := condition.GetKey()
+
+		contains := func(value string) (sq.Sqlizer, error) {
+			doc, err := json.Marshal(map[string]string{key: value})
+			if err != nil {
+				return nil, err
+			}
+
+			return sq.Expr(column+" @> ?::jsonb", doc), nil
+		}
+
+		anyOf := func(values []string) (sq.Sqlizer, error) {
+			or := sq.Or{}
+
+			for _, value := range values {
+				expr, err := contains(value)
+				if err != nil {
+					return nil, err
+				}
+
+				or = append(or, expr)
+			}
+
+			return or, nil
+		}
+
+		var (
+			expr sq.Sqlizer
+			err  error
+		)
+
+		switch condition.GetOperator() {
+		case rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_EQUALS, rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_IN:
+			expr, err = anyOf(condition.GetValues())
+		case rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_NOT_EQUALS, rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_NOT_IN:
+			expr, err = anyOf(condition.GetValues())
+			expr = not{expr}
+		case rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_EXISTS:
+			// jsonb_exists is the function form of the jsonb ? operator. The
+			// operator cannot be used here: its ?? escape is unescaped when
+			// the condition is nested in another builder, and the outer
+			// placeholder pass then turns the bare ? into a parameter.
+			expr = sq.Expr("jsonb_exists("+column+", ?)", key)
+		case rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_NOT_EXISTS:
+			expr = sq.Expr("NOT jsonb_exists("+column+", ?)", key)
+		default:
+			return nil, fmt.Errorf("unsupported attribute operator %s", condition.GetOperator())
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to build condition on attribute %s: %w", key, err)
+		}
+
+		and = append(and, expr)
+	}
+
+	return and, nil
+}
+
+// not negates a condition.
+type not struct {
+	sq.Sqlizer
+}
+
+func (n not) ToSql() (string, []any, error) {
+	sqlStr, args, err := n.Sqlizer.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return "NOT (" + sqlStr + ")", args, nil
+}
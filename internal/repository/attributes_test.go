@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+func TestSystemRecipientsQueryRendersAttributeFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		condition *rpcv1.AttributeCondition
+		wantSQL   string
+		wantArgs  []any
+	}{
+		{
+			name: "equals",
+			condition: &rpcv1.AttributeCondition{
+				Key:      "plan",
+				Operator: rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_EQUALS,
+				Values:   []string{"pro"},
+			},
+			wantSQL:  "AND ((u.attributes @> $4::jsonb))",
+			wantArgs: []any{[]byte(`{"plan":"pro"}`)},
+		},
+		{
+			name: "not in",
+			condition: &rpcv1.AttributeCondition{
+				Key:      "plan",
+				Operator: rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_NOT_IN,
+				Values:   []string{"free", "trial"},
+			},
+			wantSQL: "AND (NOT ((u.attributes @> $4::jsonb OR u.attributes @> $5::jsonb)))",
+			wantArgs: []any{
+				[]byte(`{"plan":"free"}`),
+				[]byte(`{"plan":"trial"}`),
+			},
+		},
+		{
+			name: "exists",
+			condition: &rpcv1.AttributeCondition{
+				Key:      "plan",
+				Operator: rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_EXISTS,
+			},
+			wantSQL:  "AND (jsonb_exists(u.attributes, $4))",
+			wantArgs: []any{"plan"},
+		},
+		{
+			name: "not exists",
+			condition: &rpcv1.AttributeCondition{
+				Key:      "plan",
+				Operator: rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_NOT_EXISTS,
+			},
+			wantSQL:  "AND (NOT jsonb_exists(u.attributes, $4))",
+			wantArgs: []any{"plan"},
+		},
+	}
+
+	r := &postgresRep{sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar)}
+	createdAt := time.Date(2025, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := &rpcv1.AttributeFilter{Conditions: []*rpcv1.AttributeCondition{tt.condition}}
+
+			query, err := r.systemRecipientsQuery("notification", createdAt, "system", filter)
+			if err != nil {
+				t.Fatalf("systemRecipientsQuery() error = %v", err)
+			}
+
+			sqlStr, args, err := query.ToSql()
+			if err != nil {
+				t.Fatalf("ToSql() error = %v", err)
+			}
+
+			if !strings.HasSuffix(sqlStr, tt.wantSQL) {
+				t.Errorf("sql = %q, want suffix %q", sqlStr, tt.wantSQL)
+			}
+
+			if strings.Contains(sqlStr, "?") {
+				t.Errorf("sql %q contains a bare ?", sqlStr)
+			}
+
+			wantArgs := append([]any{"notification", createdAt, "system"}, tt.wantArgs...)
+			if got := strings.Count(sqlStr, "$"); got != len(wantArgs) {
+				t.Errorf("sql %q has %d placeholders, want %d", sqlStr, got, len(wantArgs))
+			}
+
+			if len(args) != len(wantArgs) {
+				t.Fatalf("args = %v, want %v", args, wantArgs)
+			}
+
+			for i := range wantArgs {
+				if got, want := toComparable(args[i]), toComparable(wantArgs[i]); got != want {
+					t.Errorf("args[%d] = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func toComparable(v any) any {
+	if b, ok := v.([]byte); ok {
+		return string(b)
+	}
+
+	return v
+}
@@ -0,0 +1,393 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/notification-system-moxicom/persistence-service/internal/quiethours"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+// NotificationBatchItem is one notification of CreateNotificationBatch.
+type NotificationBatchItem struct {
+	UserIDs           []string // ids at system
+	Content           string
+	StructuredContent *rpcv1.NotificationContent
+	SendAt            time.Time
+	StrictRecipients  bool
+	Urgent            bool   // reaches recipients during their quiet hours too
+	Category          string // recipients who muted it are suppressed
+	LowPriority       bool   // recipients with a digest mode get it in a digest
+}
+
+// batchRecipient is a user looked up for a batch, with the timezone, quiet
+// hours and preferences that apply to it.
+type batchRecipient struct {
+	DispatchRecipient
+	timezone    string
+	quietHours  *quiethours.Schedule
+	preferences recipientPreferences
+}
+
+// NotificationBatchResult reports the outcome of one NotificationBatchItem.
+// Err is set when the item was rejected, nothing is stored for it then.
+type NotificationBatchResult struct {
+	CreateNotificationResult
+	Err error
+}
+
+// CreateNotificationBatch stores every item as a separate notification of the
+// system within one transaction. Recipients of all items are resolved by a
+// single query, notifications, recipients and their outbox events are written
+// with COPY, so the batch size is not bound by the query parameter limit.
+// Items whose recipients cannot be resolved are rejected one by one, any other
+// failure, including an exhausted quota, fails the whole batch. Items are
+// deduplicated by their content within the dedup window of the system, against
+// stored notifications and earlier items of the batch.
+func (r *postgresRep) CreateNotificationBatch(
+	ctx context.Context,
+	systemID string,
+	items []NotificationBatchItem,
+) ([]NotificationBatchResult, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	users, err := r.lookupUsers(ctx, tx, systemID, batchUserIDs(items))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		now       = time.Now().UTC()
+		variants  = make([][]byte, len(items))
+		dedupKeys = make([]string, len(items))
+		userIDs   = make([]string, 0, len(users))
+	)
+
+	for i, item := range items {
+		if variants[i], err = marshalContentVariants(item.StructuredContent); err != nil {
+			return nil, fmt.Errorf("failed to marshal content variants of item %d: %w", i, err)
+		}
+
+		dedupKeys[i] = contentDedupKey(item.Content, variants[i])
+	}
+
+	for _, user := range users {
+		userIDs = append(userIDs, user.UserID)
+	}
+
+	duplicates, err := r.loadBatchDuplicates(ctx, tx, systemID, now, dedupKeys, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		results          = make([]NotificationBatchResult, len(items))
+		notificationRows [][]any
+		recipientRows    [][]any
+		digestItemRows   [][]any
+		outboxRows       [][]any
+		categories       = make(map[string]categoryPolicy)
+	)
+
+	for i, item := range items {
+		result := &results[i]
+
+		found := make(map[string]struct{}, len(item.UserIDs))
+		for _, id := range item.UserIDs {
+			if _, ok := users[id]; ok {
+				found[id] = struct{}{}
+			}
+		}
+
+		result.Resolved, result.Unresolved = splitResolved(item.UserIDs, found)
+
+		if len(result.Resolved) == 0 || (item.StrictRecipients && len(result.Unresolved) > 0) {
+			result.Err = unresolvedRecipientsError(systemID, result.Unresolved)
+			continue
+		}
+
+		category, ok := categories[item.Category]
+		if !ok {
+			if category, err = r.loadCategoryPolicy(ctx, tx, systemID, item.Category); err != nil {
+				return nil, fmt.Errorf("failed to load category of item %d: %w", i, err)
+			}
+
+			categories[item.Category] = category
+		}
+
+		key := newNotificationKey(now)
+		notificationID, createdAt := key.ID, key.CreatedAt
+		notifStatus := notificationStatusPending
+
+		var sendAt sql.NullTime
+
+		deliverAt := now
+
+		if item.SendAt.After(now) {
+			notifStatus = notificationStatusScheduled
+			sendAt = sql.NullTime{Time: item.SendAt.UTC(), Valid: true}
+			deliverAt = sendAt.Time
+		}
+
+		recipients := make([]DispatchRecipient, 0, len(result.Resolved))
+
+		for _, id := range result.Resolved {
+			user := users[id]
+			recipient := user.DispatchRecipient
+			recipient.RecipientID = uuid.NewString()
+
+			if duplicates.isDuplicate(dedupKeys[i], recipient.UserID) {
+				result.DeduplicatedCount++
+
+				recipientRows = append(recipientRows, []any{
+					recipient.RecipientID, notificationID, recipient.UserID, deliveryStatusDeduplicated, nil, nil, createdAt, now,
+				})
+
+				continue
+			}
+
+			if user.preferences.apply(&recipient, category) {
+				result.SuppressedCount++
+
+				recipientRows = append(recipientRows, []any{
+					recipient.RecipientID, notificationID, recipient.UserID, deliveryStatusSuppressed,
+					StatusReasonSuppressedByPreference, nil, createdAt, now,
+				})
+
+				continue
+			}
+
+			duplicates.add(dedupKeys[i], recipient.UserID)
+
+			if mode := effectiveDigestMode(user.preferences.digestMode.String, category); item.LowPriority && mode != "" {
+				var dueAt time.Time
+
+				if dueAt, err = digestDueAt(mode, deliverAt, user.timezone); err != nil {
+					return nil, fmt.Errorf("invalid timezone of user %s: %w", recipient.UserID, err)
+				}
+
+				result.DigestedCount++
+
+				recipientRows = append(recipientRows, []any{
+					recipient.RecipientID, notificationID, recipient.UserID, deliveryStatusDigested, nil, nil, createdAt, now,
+				})
+				digestItemRows = append(digestItemRows, []any{
+					uuid.NewString(), systemID, recipient.UserID, notificationID, createdAt, recipient.RecipientID,
+					item.Content, dueAt, now,
+				})
+
+				continue
+			}
+
+			var deliverAfter sql.NullTime
+
+			if !item.Urgent && user.quietHours != nil {
+				if after, deferred := user.quietHours.DeliverAfter(deliverAt); deferred {
+					deliverAfter = sql.NullTime{Time: after, Valid: true}
+					recipient.DeliverAfter = &after
+				}
+			}
+
+			recipients = append(recipients, recipient)
+
+			recipientRows = append(recipientRows, []any{
+				recipient.RecipientID, notificationID, recipient.UserID, deliveryStatusQueued, nil, deliverAfter, createdAt, now,
+			})
+		}
+
+		// Nothing is left to deliver when every recipient is deduplicated,
+		// suppressed or digested.
+		if len(recipients) == 0 {
+			notifStatus = notificationStatusSent
+		}
+
+		notificationRows = append(notificationRows, []any{
+			notificationID, systemID, item.Content, variants[i], notifStatus, createdAt, sendAt, dedupKeys[i],
+			nullString(item.Category),
+		})
+
+		result.NotificationID = notificationID
+		result.RecipientCount = int64(len(result.Resolved))
+
+		// Scheduled notifications are handed over by the scheduler once due.
+		if notifStatus != notificationStatusPending {
+			continue
+		}
+
+		event := NotificationCreatedEvent{
+			NotificationID: notificationID,
+			SystemID:       systemID,
+			Content:        item.Content,
+			CreatedAt:      createdAt,
+			Recipients:     recipients,
+		}
+
+		if event.Channels, err = resolveChannelContent(item.Content, variants[i]); err != nil {
+			return nil, fmt.Errorf("failed to resolve channel content of item %d: %w", i, err)
+		}
+
+		for _, part := range event.split() {
+			var payload []byte
+
+			if payload, err = json.Marshal(part); err != nil {
+				return nil, fmt.Errorf("failed to marshal %s event: %w", EventNotificationCreated, err)
+			}
+
+			outboxRows = append(outboxRows, []any{notificationID, EventNotificationCreated, payload, now, now})
+		}
+	}
+
+	var usage deferredUsage
+
+	if len(notificationRows) > 0 {
+		if usage, err = r.consumeQuota(ctx, tx, systemID, now, int64(len(notificationRows))); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = copyRows(ctx, tx, "notifications", []string{
+		"id", "system_id", "content", "content_variants", "status", "created_at", "send_at", "dedup_key", "category",
+	}, notificationRows); err != nil {
+		return nil, err
+	}
+
+	if err = copyRows(ctx, tx, "notification_recipients", []string{
+		"id", "notification_id", "user_id", "status", "status_reason", "deliver_after", "created_at", "updated_at",
+	}, recipientRows); err != nil {
+		return nil, err
+	}
+
+	if err = copyDigestItems(ctx, tx, digestItemRows); err != nil {
+		return nil, err
+	}
+
+	if err = copyRows(ctx, tx, "outbox_events", []string{
+		"aggregate_id", "event_type", "payload", "next_attempt_at", "created_at",
+	}, outboxRows); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.recordUsage(ctx, usage)
+
+	return results, nil
+}
+
+// lookupUsers loads the users of the system with the given ids at system and
+// the quiet hours and preferences that apply to them, keyed by id at system.
+// The ids are passed as a single array parameter.
+func (r *postgresRep) lookupUsers(
+	ctx context.Context,
+	tx pgx.Tx,
+	systemID string,
+	userIDs []string,
+) (map[string]batchRecipient, error) {
+	query := r.sb.
+		Select(
+			"u.id", "u.id_at_system", "u.email", "u.phone", "u.telegram_chat_id", effectiveTimezone, effectiveQuietHours,
+			"p.disabled_channels", "p.muted_categories", "p.subscribed_categories", "p.digest_mode",
+		).
+		From("users u").
+		Join("systems s ON s.id = u.system_id").
+		LeftJoin("user_preferences p ON p.user_id = u.id").
+		Where(sq.Eq{"u.system_id": systemID}).
+		Where("u.id_at_system = ANY(?)", userIDs)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build lookup users query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make(map[string]batchRecipient, len(userIDs))
+
+	for rows.Next() {
+		var (
+			user           batchRecipient
+			email          sql.NullString
+			phone          sql.NullString
+			telegramChatID sql.NullString
+			rawQuietHours  []byte
+		)
+
+		if err = rows.Scan(
+			&user.UserID, &user.IDAtSystem, &email, &phone, &telegramChatID, &user.timezone, &rawQuietHours,
+			&user.preferences.disabledChannels, &user.preferences.mutedCategories, &user.preferences.subscribedCategories,
+			&user.preferences.digestMode,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+
+		if user.quietHours, err = quietSchedule(user.timezone, rawQuietHours); err != nil {
+			return nil, fmt.Errorf("invalid quiet hours of user %s: %w", user.UserID, err)
+		}
+
+		user.Email = email.String
+		user.Phone = phone.String
+		user.TelegramChatID = telegramChatID.String
+		users[user.IDAtSystem] = user
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate user rows: %w", err)
+	}
+
+	return users, nil
+}
+
+// batchUserIDs returns the distinct ids at system referenced by the items.
+func batchUserIDs(items []NotificationBatchItem) []string {
+	var (
+		ids  []string
+		seen = make(map[string]struct{})
+	)
+
+	for _, item := range items {
+		for _, id := range item.UserIDs {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+func copyRows(ctx context.Context, tx pgx.Tx, table string, columns []string, rows [][]any) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{table}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("failed to copy %s: %w", table, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+// CancelNotification moves the notification and its undelivered recipients to
+// cancelled. Notifications that have already been handed to the orchestrator
+// also get a notification.cancelled event, so dispatchers drop them. Cancelling
+// a cancelled notification is a no-op, cancelling a finished one fails with
+// apperrors.InvalidTransitionError.
+func (r *postgresRep) CancelNotification(ctx context.Context, id string) (*rpcv1.Notification, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	lockQuery := r.sb.
+		Select("system_id", "status", "created_at").
+		From("notifications").
+		Where(notificationKeyOf(id).eq("id", "created_at")).
+		Suffix("FOR UPDATE")
+
+	lockSql, lockArgs, err := lockQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build lock notification query: %w", err)
+	}
+
+	var (
+		systemID, status string
+		createdAt        time.Time
+	)
+
+	if err = tx.QueryRow(ctx, lockSql, lockArgs...).Scan(&systemID, &status, &createdAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = apperrors.NewNotFoundError("notification not found", "notification "+id+" does not exist")
+
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to lock notification: %w", err)
+	}
+
+	if status == notificationStatusCancelled {
+		_ = tx.Rollback(ctx)
+
+		return r.GetNotification(ctx, id)
+	}
+
+	if err = r.transitionNotification(ctx, tx, id, createdAt, notificationStatusCancelled); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	undelivered := []string{deliveryStatusQueued, deliveryStatusSending}
+
+	recipientsQuery := r.sb.
+		Update("notification_recipients").
+		Set("status", deliveryStatusCancelled).
+		Set("updated_at", now).
+		Where(sq.Eq{"notification_id": id, "created_at": createdAt, "status": undelivered}).
+		Suffix("RETURNING id")
+
+	recipientsSql, recipientsArgs, err := recipientsQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build cancel recipients query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, recipientsSql, recipientsArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel recipients: %w", err)
+	}
+
+	var recipientIDs []string
+
+	for rows.Next() {
+		var recipientID string
+		if err = rows.Scan(&recipientID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan recipient id: %w", err)
+		}
+
+		recipientIDs = append(recipientIDs, recipientID)
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate cancelled recipients: %w", err)
+	}
+
+	if err = r.dropPendingDigestItems(ctx, tx, []notificationKey{{ID: id, CreatedAt: createdAt}}, now); err != nil {
+		return nil, err
+	}
+
+	if len(recipientIDs) > 0 {
+		deliveriesQuery := r.sb.
+			Update("notification_deliveries").
+			Set("status", deliveryStatusCancelled).
+			Set("updated_at", now).
+			Where("recipient_id = ANY(?::uuid[])", recipientIDs).
+			Where(sq.Eq{"status": undelivered})
+
+		deliveriesSql, deliveriesArgs, buildErr := deliveriesQuery.ToSql()
+		if buildErr != nil {
+			err = fmt.Errorf("failed to build cancel deliveries query: %w", buildErr)
+			return nil, err
+		}
+
+		if _, err = tx.Exec(ctx, deliveriesSql, deliveriesArgs...); err != nil {
+			return nil, fmt.Errorf("failed to cancel deliveries: %w", err)
+		}
+	}
+
+	// Scheduled notifications have not been published yet, there is nothing
+	// for dispatchers to drop.
+	if status != notificationStatusScheduled {
+		if err = r.insertOutboxEvent(ctx, tx, id, EventNotificationCancelled, NotificationCancelledEvent{
+			NotificationID: id,
+			SystemID:       systemID,
+			RecipientIDs:   recipientIDs,
+			CancelledAt:    now,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return r.GetNotification(ctx, id)
+}
@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+const categoryColumns = "system_id, name, description, default_opt_in, mandatory, digest_mode, created_at, updated_at"
+
+type CategoryRepository interface {
+	CreateCategory(ctx context.Context, category *rpcv1.Category) (*rpcv1.Category, error)
+	ListCategories(ctx context.Context, systemID string) ([]*rpcv1.Category, error)
+	UpdateCategory(
+		ctx context.Context,
+		systemID, name string,
+		description *string,
+		defaultOptIn, mandatory *bool,
+		digestMode *rpcv1.DigestMode,
+	) (*rpcv1.Category, error)
+	DeleteCategory(ctx context.Context, systemID, name string) error
+}
+
+// categoryPolicy is how a notification category reaches users. The zero value,
+// used for notifications without a category, reaches every user.
+type categoryPolicy struct {
+	name         string
+	defaultOptIn bool
+	mandatory    bool
+	digestMode   string // empty when low-priority notifications are not digested
+}
+
+func (r *postgresRep) CreateCategory(ctx context.Context, category *rpcv1.Category) (*rpcv1.Category, error) {
+	now := time.Now().UTC()
+
+	query := r.sb.
+		Insert("notification_categories").
+		Columns("system_id", "name", "description", "default_opt_in", "mandatory", "digest_mode", "created_at", "updated_at").
+		Values(
+			category.GetSystemId(),
+			category.GetName(),
+			category.GetDescription(),
+			category.GetDefaultOptIn(),
+			category.GetMandatory(),
+			digestModeToDB(category.GetDigestMode()),
+			now,
+			now,
+		).
+		Suffix("RETURNING " + categoryColumns)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := scanCategory(r.pool.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		switch {
+		case isUniqueViolation(err):
+			return nil, apperrors.NewConflictError(
+				"category already exists", "category "+category.GetName()+" already exists in the system",
+			)
+		case isForeignKeyViolation(err):
+			return nil, systemNotFound(category.GetSystemId())
+		default:
+			return nil, err
+		}
+	}
+
+	return created, nil
+}
+
+func (r *postgresRep) ListCategories(ctx context.Context, systemID string) ([]*rpcv1.Category, error) {
+	query := r.sb.
+		Select(categoryColumns).
+		From("notification_categories").
+		Where(sq.Eq{"system_id": systemID}).
+		OrderBy("name")
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var categories []*rpcv1.Category
+
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		categories = append(categories, category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *postgresRep) UpdateCategory(
+	ctx context.Context,
+	systemID, name string,
+	description *string,
+	defaultOptIn, mandatory *bool,
+	digestMode *rpcv1.DigestMode,
+) (*rpcv1.Category, error) {
+	query := r.sb.Update("notification_categories").Set("updated_at", time.Now().UTC())
+
+	if description != nil {
+		query = query.Set("description", *description)
+	}
+
+	if defaultOptIn != nil {
+		query = query.Set("default_opt_in", *defaultOptIn)
+	}
+
+	if mandatory != nil {
+		query = query.Set("mandatory", *mandatory)
+	}
+
+	if digestMode != nil {
+		query = query.Set("digest_mode", digestModeToDB(*digestMode))
+	}
+
+	query = query.Where(sq.Eq{"system_id": systemID, "name": name}).
+		Suffix("RETURNING " + categoryColumns)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := scanCategory(r.pool.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, categoryNotFound(name)
+		}
+
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// DeleteCategory removes the declaration of a category. Notifications sent
+// with it afterwards are treated as undeclared, and preferences that mention
+// it are kept.
+func (r *postgresRep) DeleteCategory(ctx context.Context, systemID, name string) error {
+	query := r.sb.
+		Delete("notification_categories").
+		Where(sq.Eq{"system_id": systemID, "name": name})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.pool.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return categoryNotFound(name)
+	}
+
+	return nil
+}
+
+// loadCategoryPolicy returns the policy of the category of the system.
+// Categories the system has not declared are opt-in and not mandatory.
+func (r *postgresRep) loadCategoryPolicy(ctx context.Context, q rowQuerier, systemID, name string) (categoryPolicy, error) {
+	if name == "" {
+		return categoryPolicy{}, nil
+	}
+
+	policy := categoryPolicy{name: name, defaultOptIn: true}
+
+	query := r.sb.
+		Select("default_opt_in", "mandatory", "COALESCE(digest_mode, '')").
+		From("notification_categories").
+		Where(sq.Eq{"system_id": systemID, "name": name})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return policy, err
+	}
+
+	err = q.QueryRow(ctx, sqlStr, args...).Scan(&policy.defaultOptIn, &policy.mandatory, &policy.digestMode)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return policy, err
+	}
+
+	return policy, nil
+}
+
+func scanCategory(row pgx.Row) (*rpcv1.Category, error) {
+	var (
+		category   rpcv1.Category
+		digestMode sql.NullString
+		createdAt  time.Time
+		updatedAt  time.Time
+	)
+
+	if err := row.Scan(
+		&category.SystemId,
+		&category.Name,
+		&category.Description,
+		&category.DefaultOptIn,
+		&category.Mandatory,
+		&digestMode,
+		&createdAt,
+		&updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	category.DigestMode = digestModeFromDB(digestMode)
+	category.CreatedAt = createdAt.Unix()
+	category.UpdatedAt = updatedAt.Unix()
+
+	return &category, nil
+}
+
+func categoryNotFound(name string) error {
+	return apperrors.NewNotFoundError("category not found", "category "+name+" does not exist in the system")
+}
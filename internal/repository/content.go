@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"encoding/json"
+
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+// ChannelContent is the content each channel receives after the fallback rule
+// has been applied.
+type ChannelContent struct {
+	Email    EmailContent    `json:"email"`
+	SMS      SMSContent      `json:"sms"`
+	Telegram TelegramContent `json:"telegram"`
+}
+
+type EmailContent struct {
+	Subject  string `json:"subject,omitempty"`
+	HTMLBody string `json:"html_body,omitempty"`
+	TextBody string `json:"text_body"`
+}
+
+type SMSContent struct {
+	Text string `json:"text"`
+}
+
+type TelegramContent struct {
+	Text     string `json:"text"`
+	Markdown bool   `json:"markdown"` // whether Text must be sent with Markdown parsing
+}
+
+// contentVariants is how per-channel variants are stored in
+// notifications.content_variants.
+type contentVariants struct {
+	Email    *emailVariant    `json:"email,omitempty"`
+	SMS      *smsVariant      `json:"sms,omitempty"`
+	Telegram *telegramVariant `json:"telegram,omitempty"`
+}
+
+type emailVariant struct {
+	Subject  string `json:"subject,omitempty"`
+	HTMLBody string `json:"html_body,omitempty"`
+	TextBody string `json:"text_body,omitempty"`
+}
+
+type smsVariant struct {
+	Text string `json:"text"`
+}
+
+type telegramVariant struct {
+	Markdown string `json:"markdown"`
+}
+
+// marshalContentVariants returns nil when content carries no variants, so that
+// plain notifications keep a NULL column.
+func marshalContentVariants(content *rpcv1.NotificationContent) ([]byte, error) {
+	var variants contentVariants
+
+	if email := content.GetEmail(); email != nil {
+		variants.Email = &emailVariant{
+			Subject:  email.GetSubject(),
+			HTMLBody: email.GetHtmlBody(),
+			TextBody: email.GetTextBody(),
+		}
+	}
+
+	if sms := content.GetSms(); sms != nil {
+		variants.SMS = &smsVariant{Text: sms.GetText()}
+	}
+
+	if telegram := content.GetTelegram(); telegram != nil {
+		variants.Telegram = &telegramVariant{Markdown: telegram.GetMarkdown()}
+	}
+
+	if variants.Email == nil && variants.SMS == nil && variants.Telegram == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(variants)
+}
+
+func unmarshalContentVariants(raw []byte) (contentVariants, error) {
+	var variants contentVariants
+
+	if len(raw) == 0 {
+		return variants, nil
+	}
+
+	err := json.Unmarshal(raw, &variants)
+
+	return variants, err
+}
+
+// contentToProto rebuilds the structured content of a stored notification, or
+// nil if it has no variants.
+func contentToProto(text string, raw []byte) (*rpcv1.NotificationContent, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	variants, err := unmarshalContentVariants(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &rpcv1.NotificationContent{Text: text}
+
+	if variants.Email != nil {
+		content.Email = &rpcv1.EmailContent{
+			Subject:  variants.Email.Subject,
+			HtmlBody: variants.Email.HTMLBody,
+			TextBody: variants.Email.TextBody,
+		}
+	}
+
+	if variants.SMS != nil {
+		content.Sms = &rpcv1.SmsContent{Text: variants.SMS.Text}
+	}
+
+	if variants.Telegram != nil {
+		content.Telegram = &rpcv1.TelegramContent{Markdown: variants.Telegram.Markdown}
+	}
+
+	return content, nil
+}
+
+// resolveChannelContent applies the fallback rule: a channel gets its own
+// variant when there is one and the plain text otherwise. Email without a text
+// body falls back to the plain text as well.
+func resolveChannelContent(text string, raw []byte) (ChannelContent, error) {
+	variants, err := unmarshalContentVariants(raw)
+	if err != nil {
+		return ChannelContent{}, err
+	}
+
+	resolved := ChannelContent{
+		Email:    EmailContent{TextBody: text},
+		SMS:      SMSContent{Text: text},
+		Telegram: TelegramContent{Text: text},
+	}
+
+	if variants.Email != nil {
+		resolved.Email.Subject = variants.Email.Subject
+		resolved.Email.HTMLBody = variants.Email.HTMLBody
+
+		if variants.Email.TextBody != "" {
+			resolved.Email.TextBody = variants.Email.TextBody
+		}
+	}
+
+	if variants.SMS != nil && variants.SMS.Text != "" {
+		resolved.SMS.Text = variants.SMS.Text
+	}
+
+	if variants.Telegram != nil && variants.Telegram.Markdown != "" {
+		resolved.Telegram = TelegramContent{Text: variants.Telegram.Markdown, Markdown: true}
+	}
+
+	return resolved, nil
+}
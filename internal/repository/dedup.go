@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+// contentDedupKey identifies notifications by their content when the caller
+// has not supplied a dedup key.
+func contentDedupKey(content string, variants []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(content))
+	hash.Write([]byte{0})
+	hash.Write(variants)
+
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil))
+}
+
+// deduplicateRecipients marks the recipients of the new notification that
+// another notification of the system with the same dedup key has reached
+// within the window as deduplicated, and returns how many were marked. A
+// positive window overrides the one of the system. Notifications with the same
+// key are serialized by an advisory lock, so concurrent duplicates see each
+// other once the first one is committed.
+func (r *postgresRep) deduplicateRecipients(
+	ctx context.Context,
+	tx pgx.Tx,
+	notificationID string,
+	createdAt time.Time,
+	systemID string,
+	dedupKey string,
+	window time.Duration,
+) (int64, error) {
+	if window <= 0 {
+		var err error
+
+		if window, err = r.systemDedupWindow(ctx, tx, systemID); err != nil {
+			return 0, err
+		}
+	}
+
+	if window <= 0 {
+		return 0, nil
+	}
+
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtextextended($1, 0))", systemID+"/"+dedupKey); err != nil {
+		return 0, fmt.Errorf("failed to lock dedup key: %w", err)
+	}
+
+	since := createdAt.Add(-window)
+
+	query := r.sb.
+		Update("notification_recipients r").
+		Set("status", deliveryStatusDeduplicated).
+		Where(sq.Eq{"r.notification_id": notificationID, "r.created_at": createdAt}).
+		Where(`EXISTS (
+			SELECT 1 FROM notification_recipients p
+			JOIN notifications n ON n.id = p.notification_id AND n.created_at = p.created_at
+			WHERE n.system_id = ? AND n.dedup_key = ? AND n.created_at >= ? AND n.id <> ?
+				AND p.created_at >= ? AND p.user_id = r.user_id AND p.status NOT IN (?, ?, ?)
+		)`,
+			systemID, dedupKey, since, notificationID, since,
+			deliveryStatusDeduplicated, deliveryStatusCancelled, deliveryStatusSuppressed,
+		)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build deduplicate recipients query: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to deduplicate recipients: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *postgresRep) systemDedupWindow(ctx context.Context, tx pgx.Tx, systemID string) (time.Duration, error) {
+	query := r.sb.
+		Select("dedup_window_seconds").
+		From("systems").
+		Where(sq.Eq{"id": systemID})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build system dedup window query: %w", err)
+	}
+
+	var seconds int64
+
+	if err = tx.QueryRow(ctx, sqlStr, args...).Scan(&seconds); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+
+		return 0, fmt.Errorf("failed to load system dedup window: %w", err)
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// batchDuplicates tracks which users the notifications of a system with each
+// dedup key have reached within the dedup window, stored ones and earlier
+// items of the same batch alike. A nil reached map means deduplication is off.
+type batchDuplicates struct {
+	reached map[string]map[string]struct{}
+}
+
+// isDuplicate reports whether a notification with the dedup key has already
+// reached the user.
+func (d batchDuplicates) isDuplicate(dedupKey, userID string) bool {
+	_, ok := d.reached[dedupKey][userID]
+
+	return ok
+}
+
+// add records that a notification with the dedup key reaches the user.
+func (d batchDuplicates) add(dedupKey, userID string) {
+	if d.reached == nil {
+		return
+	}
+
+	users, ok := d.reached[dedupKey]
+	if !ok {
+		users = make(map[string]struct{})
+		d.reached[dedupKey] = users
+	}
+
+	users[userID] = struct{}{}
+}
+
+// loadBatchDuplicates locks the dedup keys of a batch and loads which of the
+// users stored notifications with those keys have reached within the dedup
+// window of the system. The keys are locked in a fixed order, so concurrent
+// batches sharing keys cannot deadlock.
+func (r *postgresRep) loadBatchDuplicates(
+	ctx context.Context,
+	tx pgx.Tx,
+	systemID string,
+	now time.Time,
+	dedupKeys []string,
+	userIDs []string,
+) (batchDuplicates, error) {
+	window, err := r.systemDedupWindow(ctx, tx, systemID)
+	if err != nil || window <= 0 {
+		return batchDuplicates{}, err
+	}
+
+	lockKeys := make([]string, 0, len(dedupKeys))
+	for _, key := range dedupKeys {
+		lockKeys = append(lockKeys, systemID+"/"+key)
+	}
+
+	if _, err = tx.Exec(ctx, `
+		SELECT pg_advisory_xact_lock(hashtextextended(k, 0))
+		FROM (SELECT DISTINCT k FROM unnest($1::text[]) k ORDER BY k) keys`,
+		lockKeys,
+	); err != nil {
+		return batchDuplicates{}, fmt.Errorf("failed to lock dedup keys: %w", err)
+	}
+
+	since := now.Add(-window)
+
+	query := r.sb.
+		Select("n.dedup_key", "p.user_id").
+		Distinct().
+		From("notification_recipients p").
+		Join("notifications n ON n.id = p.notification_id AND n.created_at = p.created_at").
+		Where(sq.Eq{"n.system_id": systemID}).
+		Where("n.dedup_key = ANY(?)", dedupKeys).
+		Where(sq.GtOrEq{"n.created_at": since, "p.created_at": since}).
+		Where("p.user_id = ANY(?::uuid[])", userIDs).
+		Where(sq.NotEq{"p.status": []string{deliveryStatusDeduplicated, deliveryStatusCancelled, deliveryStatusSuppressed}})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return batchDuplicates{}, fmt.Errorf("failed to build reached recipients query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return batchDuplicates{}, fmt.Errorf("failed to load reached recipients: %w", err)
+	}
+	defer rows.Close()
+
+	duplicates := batchDuplicates{reached: make(map[string]map[string]struct{})}
+
+	for rows.Next() {
+		var dedupKey, userID string
+		if err = rows.Scan(&dedupKey, &userID); err != nil {
+			return batchDuplicates{}, fmt.Errorf("failed to scan reached recipient: %w", err)
+		}
+
+		duplicates.add(dedupKey, userID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return batchDuplicates{}, fmt.Errorf("failed to iterate reached recipients: %w", err)
+	}
+
+	return duplicates, nil
+}
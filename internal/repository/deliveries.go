@@ -0,0 +1,401 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+const (
+	deliveryStatusQueued       = "queued"
+	deliveryStatusSending      = "sending"
+	deliveryStatusDelivered    = "delivered"
+	deliveryStatusFailed       = "failed"
+	deliveryStatusBounced      = "bounced"
+	deliveryStatusCancelled    = "cancelled"
+	deliveryStatusDeduplicated = "deduplicated"
+	deliveryStatusSuppressed   = "suppressed"
+	deliveryStatusDigested     = "digested"
+
+	channelEmail    = "email"
+	channelSMS      = "sms"
+	channelTelegram = "telegram"
+)
+
+func deliveryStatusToDB(status rpcv1.DeliveryStatus) string {
+	switch status {
+	case rpcv1.DeliveryStatus_DELIVERY_STATUS_QUEUED:
+		return deliveryStatusQueued
+	case rpcv1.DeliveryStatus_DELIVERY_STATUS_SENDING:
+		return deliveryStatusSending
+	case rpcv1.DeliveryStatus_DELIVERY_STATUS_DELIVERED:
+		return deliveryStatusDelivered
+	case rpcv1.DeliveryStatus_DELIVERY_STATUS_FAILED:
+		return deliveryStatusFailed
+	case rpcv1.DeliveryStatus_DELIVERY_STATUS_BOUNCED:
+		return deliveryStatusBounced
+	case rpcv1.DeliveryStatus_DELIVERY_STATUS_CANCELLED:
+		return deliveryStatusCancelled
+	case rpcv1.DeliveryStatus_DELIVERY_STATUS_DEDUPLICATED:
+		return deliveryStatusDeduplicated
+	case rpcv1.DeliveryStatus_DELIVERY_STATUS_SUPPRESSED:
+		return deliveryStatusSuppressed
+	case rpcv1.DeliveryStatus_DELIVERY_STATUS_DIGESTED:
+		return deliveryStatusDigested
+	default:
+		return ""
+	}
+}
+
+func deliveryStatusFromDB(status string) rpcv1.DeliveryStatus {
+	switch status {
+	case deliveryStatusQueued:
+		return rpcv1.DeliveryStatus_DELIVERY_STATUS_QUEUED
+	case deliveryStatusSending:
+		return rpcv1.DeliveryStatus_DELIVERY_STATUS_SENDING
+	case deliveryStatusDelivered:
+		return rpcv1.DeliveryStatus_DELIVERY_STATUS_DELIVERED
+	case deliveryStatusFailed:
+		return rpcv1.DeliveryStatus_DELIVERY_STATUS_FAILED
+	case deliveryStatusBounced:
+		return rpcv1.DeliveryStatus_DELIVERY_STATUS_BOUNCED
+	case deliveryStatusCancelled:
+		return rpcv1.DeliveryStatus_DELIVERY_STATUS_CANCELLED
+	case deliveryStatusDeduplicated:
+		return rpcv1.DeliveryStatus_DELIVERY_STATUS_DEDUPLICATED
+	case deliveryStatusSuppressed:
+		return rpcv1.DeliveryStatus_DELIVERY_STATUS_SUPPRESSED
+	case deliveryStatusDigested:
+		return rpcv1.DeliveryStatus_DELIVERY_STATUS_DIGESTED
+	default:
+		return rpcv1.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
+
+func channelToDB(channel rpcv1.Channel) string {
+	switch channel {
+	case rpcv1.Channel_CHANNEL_EMAIL:
+		return channelEmail
+	case rpcv1.Channel_CHANNEL_SMS:
+		return channelSMS
+	case rpcv1.Channel_CHANNEL_TELEGRAM:
+		return channelTelegram
+	default:
+		return ""
+	}
+}
+
+func channelFromDB(channel string) rpcv1.Channel {
+	switch channel {
+	case channelEmail:
+		return rpcv1.Channel_CHANNEL_EMAIL
+	case channelSMS:
+		return rpcv1.Channel_CHANNEL_SMS
+	case channelTelegram:
+		return rpcv1.Channel_CHANNEL_TELEGRAM
+	default:
+		return rpcv1.Channel_CHANNEL_UNSPECIFIED
+	}
+}
+
+const deliveryColumns = "d.recipient_id, r.notification_id, r.user_id, d.channel, d.status, d.attempts, " +
+	"d.last_error, d.last_attempt_at, d.delivered_at, d.updated_at"
+
+// upsertDeliverySuffix merges a reported status into an existing delivery row.
+// An attempt is counted when the dispatcher starts sending, or when it reports an
+// outcome without having reported sending first.
+const upsertDeliverySuffix = `ON CONFLICT (recipient_id, channel) DO UPDATE SET
+	status = EXCLUDED.status,
+	attempts = notification_deliveries.attempts + CASE
+		WHEN EXCLUDED.status = 'sending' THEN 1
+		WHEN EXCLUDED.status <> 'queued' AND notification_deliveries.status <> 'sending' THEN 1
+		ELSE 0
+	END,
+	last_error = COALESCE(EXCLUDED.last_error, notification_deliveries.last_error),
+	last_attempt_at = COALESCE(EXCLUDED.last_attempt_at, notification_deliveries.last_attempt_at),
+	delivered_at = COALESCE(notification_deliveries.delivered_at, EXCLUDED.delivered_at),
+	updated_at = EXCLUDED.updated_at
+RETURNING recipient_id, channel, status, attempts, last_error, last_attempt_at, delivered_at, updated_at`
+
+// UpdateRecipientStatus records a status reported for a recipient on a channel.
+// notificationCreatedAt is optional, when set it locates notifications whose id
+// does not carry its created_at.
+func (r *postgresRep) UpdateRecipientStatus(
+	ctx context.Context,
+	notificationID string,
+	notificationCreatedAt time.Time,
+	userID string,
+	channel rpcv1.Channel,
+	status rpcv1.DeliveryStatus,
+	lastError string,
+) (*rpcv1.RecipientDelivery, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	// Reports on one notification are serialized by the notification row lock,
+	// so its status is always derived from up to date recipient statuses.
+	notifQuery := r.sb.
+		Select("status", "created_at").
+		From("notifications").
+		Where(notificationKeyOf(notificationID).withCreatedAt(notificationCreatedAt).eq("id", "created_at")).
+		Suffix("FOR UPDATE")
+
+	notifSql, notifArgs, err := notifQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build notification lookup query: %w", err)
+	}
+
+	var (
+		notifStatus string
+		createdAt   time.Time
+	)
+
+	if err = tx.QueryRow(ctx, notifSql, notifArgs...).Scan(&notifStatus, &createdAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = apperrors.NewNotFoundError("notification not found", "notification "+notificationID+" does not exist")
+
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to lock notification: %w", err)
+	}
+
+	lookupQuery := r.sb.
+		Select("id", "status").
+		From("notification_recipients").
+		Where(sq.Eq{
+			"notification_id": notificationID,
+			"created_at":      createdAt,
+			"user_id":         userID,
+		}).
+		Suffix("FOR UPDATE")
+
+	lookupSql, lookupArgs, err := lookupQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build recipient lookup query: %w", err)
+	}
+
+	var recipientID, recipientStatus string
+
+	if err = tx.QueryRow(ctx, lookupSql, lookupArgs...).Scan(&recipientID, &recipientStatus); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = apperrors.NewNotFoundError(
+				"recipient not found",
+				fmt.Sprintf("user %s is not a recipient of notification %s", userID, notificationID),
+			)
+
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to lookup recipient: %w", err)
+	}
+
+	dbStatus := deliveryStatusToDB(status)
+
+	// Cancelled, deduplicated, suppressed and digested recipients were never
+	// handed to dispatchers, digested ones are delivered by their digest.
+	if recipientStatus == deliveryStatusCancelled ||
+		recipientStatus == deliveryStatusDeduplicated ||
+		recipientStatus == deliveryStatusSuppressed ||
+		recipientStatus == deliveryStatusDigested {
+		err = apperrors.NewInvalidTransitionError("recipient", recipientID, recipientStatus, dbStatus)
+
+		return nil, err
+	}
+
+	var (
+		now           = time.Now().UTC()
+		attempts      int32
+		lastAttemptAt sql.NullTime
+		deliveredAt   sql.NullTime
+		errorText     sql.NullString
+	)
+
+	if dbStatus != deliveryStatusQueued {
+		attempts = 1
+		lastAttemptAt = sql.NullTime{Time: now, Valid: true}
+	}
+
+	if dbStatus == deliveryStatusDelivered {
+		deliveredAt = sql.NullTime{Time: now, Valid: true}
+	}
+
+	if lastError != "" {
+		errorText = sql.NullString{String: lastError, Valid: true}
+	}
+
+	upsertQuery := r.sb.
+		Insert("notification_deliveries").
+		Columns(
+			"recipient_id", "channel", "status", "attempts", "last_error",
+			"last_attempt_at", "delivered_at", "created_at", "updated_at",
+		).
+		Values(recipientID, channelToDB(channel), dbStatus, attempts, errorText, lastAttemptAt, deliveredAt, now, now).
+		Suffix(upsertDeliverySuffix)
+
+	upsertSql, upsertArgs, err := upsertQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build upsert delivery query: %w", err)
+	}
+
+	var (
+		outRecipientID string
+		outChannel     string
+		outStatus      string
+		outAttempts    int32
+		outLastError   sql.NullString
+		outLastAttempt sql.NullTime
+		outDelivered   sql.NullTime
+		outUpdatedAt   time.Time
+	)
+
+	if err = tx.QueryRow(ctx, upsertSql, upsertArgs...).Scan(
+		&outRecipientID, &outChannel, &outStatus, &outAttempts,
+		&outLastError, &outLastAttempt, &outDelivered, &outUpdatedAt,
+	); err != nil {
+		return nil, fmt.Errorf("failed to upsert delivery: %w", err)
+	}
+
+	// A recipient counts as delivered once any of its channels has delivered,
+	// later reports on other channels must not downgrade it.
+	recipientQuery := r.sb.
+		Update("notification_recipients").
+		Set("status", dbStatus).
+		Set("updated_at", now).
+		Where(sq.Eq{"id": recipientID, "created_at": createdAt}).
+		Where(sq.NotEq{"status": deliveryStatusDelivered})
+
+	recipientSql, recipientArgs, err := recipientQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update recipient query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, recipientSql, recipientArgs...); err != nil {
+		return nil, fmt.Errorf("failed to update recipient status: %w", err)
+	}
+
+	if err = r.syncNotificationStatus(ctx, tx, notificationID, createdAt, notifStatus); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return newRecipientDelivery(
+		outRecipientID, notificationID, userID, outChannel, outStatus, outAttempts,
+		outLastError, outLastAttempt, outDelivered, outUpdatedAt,
+	), nil
+}
+
+func (r *postgresRep) ListRecipientDeliveries(ctx context.Context, notificationID string) ([]*rpcv1.RecipientDelivery, error) {
+	return r.listRecipientDeliveries(ctx, notificationKeyOf(notificationID))
+}
+
+func (r *postgresRep) listRecipientDeliveries(
+	ctx context.Context,
+	notification notificationKey,
+) ([]*rpcv1.RecipientDelivery, error) {
+	query := r.sb.
+		Select(deliveryColumns).
+		From("notification_deliveries d").
+		Join("notification_recipients r ON r.id = d.recipient_id").
+		Where(notification.eq("r.notification_id", "r.created_at")).
+		OrderBy("d.recipient_id", "d.channel")
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*rpcv1.RecipientDelivery
+
+	for rows.Next() {
+		var (
+			recipientID   string
+			outNotifID    string
+			userID        string
+			channel       string
+			status        string
+			attempts      int32
+			lastError     sql.NullString
+			lastAttemptAt sql.NullTime
+			deliveredAt   sql.NullTime
+			updatedAt     time.Time
+		)
+
+		if err := rows.Scan(
+			&recipientID, &outNotifID, &userID, &channel, &status, &attempts,
+			&lastError, &lastAttemptAt, &deliveredAt, &updatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, newRecipientDelivery(
+			recipientID, outNotifID, userID, channel, status, attempts,
+			lastError, lastAttemptAt, deliveredAt, updatedAt,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func newRecipientDelivery(
+	recipientID,
+	notificationID,
+	userID,
+	channel,
+	status string,
+	attempts int32,
+	lastError sql.NullString,
+	lastAttemptAt,
+	deliveredAt sql.NullTime,
+	updatedAt time.Time,
+) *rpcv1.RecipientDelivery {
+	delivery := &rpcv1.RecipientDelivery{
+		RecipientId:    recipientID,
+		NotificationId: notificationID,
+		UserId:         userID,
+		Channel:        channelFromDB(channel),
+		Status:         deliveryStatusFromDB(status),
+		Attempts:       attempts,
+		LastError:      lastError.String,
+		UpdatedAt:      updatedAt.Unix(),
+	}
+
+	if lastAttemptAt.Valid {
+		delivery.LastAttemptAt = lastAttemptAt.Time.Unix()
+	}
+
+	if deliveredAt.Valid {
+		delivery.DeliveredAt = deliveredAt.Time.Unix()
+	}
+
+	return delivery
+}
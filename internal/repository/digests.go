@@ -0,0 +1,498 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/notification-system-moxicom/persistence-service/internal/quiethours"
+	"github.com/notification-system-moxicom/persistence-service/internal/templating"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+const (
+	digestModeOff    = "off"
+	digestModeHourly = "hourly"
+	digestModeDaily  = "daily"
+
+	// defaultDigestBody renders digests of systems without a digest template.
+	// Templates get the same variables: count, the number of notifications,
+	// and items, their contents one per line.
+	defaultDigestBody = "You have {{count}} new notifications:\n{{items}}"
+)
+
+type DigestRepository interface {
+	RollupDigests(ctx context.Context, now time.Time, templateName string, limit int) (int, error)
+}
+
+func digestModeToDB(mode rpcv1.DigestMode) sql.NullString {
+	switch mode {
+	case rpcv1.DigestMode_DIGEST_MODE_OFF:
+		return nullString(digestModeOff)
+	case rpcv1.DigestMode_DIGEST_MODE_HOURLY:
+		return nullString(digestModeHourly)
+	case rpcv1.DigestMode_DIGEST_MODE_DAILY:
+		return nullString(digestModeDaily)
+	default:
+		return sql.NullString{}
+	}
+}
+
+func digestModeFromDB(mode sql.NullString) rpcv1.DigestMode {
+	switch mode.String {
+	case digestModeOff:
+		return rpcv1.DigestMode_DIGEST_MODE_OFF
+	case digestModeHourly:
+		return rpcv1.DigestMode_DIGEST_MODE_HOURLY
+	case digestModeDaily:
+		return rpcv1.DigestMode_DIGEST_MODE_DAILY
+	default:
+		return rpcv1.DigestMode_DIGEST_MODE_UNSPECIFIED
+	}
+}
+
+// effectiveDigestMode returns the digest mode of a user for a category, empty
+// when notifications are not digested. The mode of the user wins.
+func effectiveDigestMode(userMode string, category categoryPolicy) string {
+	mode := category.digestMode
+	if userMode != "" {
+		mode = userMode
+	}
+
+	if mode == digestModeOff {
+		return ""
+	}
+
+	return mode
+}
+
+// digestDueAt returns when the digest period containing at ends: the next full
+// hour, or the next midnight in the timezone of the user.
+func digestDueAt(mode string, at time.Time, timezone string) (time.Time, error) {
+	location, err := quiethours.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	local := at.In(location)
+	year, month, day := local.Date()
+
+	if mode == digestModeDaily {
+		return time.Date(year, month, day+1, 0, 0, 0, 0, location).UTC(), nil
+	}
+
+	return time.Date(year, month, day, local.Hour()+1, 0, 0, 0, location).UTC(), nil
+}
+
+// digestRecipients moves the queued recipients of a low-priority notification
+// that have a digest mode into digest items, marks them as digested and
+// returns how many were moved. deliverAt is when the notification is handed
+// over, it decides the digest period.
+func (r *postgresRep) digestRecipients(
+	ctx context.Context,
+	tx pgx.Tx,
+	notificationID string,
+	createdAt time.Time,
+	deliverAt time.Time,
+	category categoryPolicy,
+	content string,
+) (int64, error) {
+	query := r.sb.
+		Select("r.id", "r.user_id", "u.system_id", effectiveTimezone, "p.digest_mode").
+		From("notification_recipients r").
+		Join("users u ON u.id = r.user_id").
+		Join("systems s ON s.id = u.system_id").
+		Where(sq.Eq{
+			"r.notification_id": notificationID,
+			"r.created_at":      createdAt,
+			"r.status":          deliveryStatusQueued,
+		})
+
+	// Without a mode on the category only users with a mode of their own are
+	// digested.
+	if category.digestMode == "" || category.digestMode == digestModeOff {
+		query = query.Join("user_preferences p ON p.user_id = r.user_id AND p.digest_mode IS NOT NULL")
+	} else {
+		query = query.LeftJoin("user_preferences p ON p.user_id = r.user_id")
+	}
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build digest recipients query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load digest recipients: %w", err)
+	}
+
+	var (
+		recipientIDs []string
+		itemRows     [][]any
+	)
+
+	for rows.Next() {
+		var (
+			recipientID string
+			userID      string
+			systemID    string
+			timezone    string
+			userMode    sql.NullString
+			dueAt       time.Time
+		)
+
+		if err = rows.Scan(&recipientID, &userID, &systemID, &timezone, &userMode); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan digest recipient: %w", err)
+		}
+
+		mode := effectiveDigestMode(userMode.String, category)
+		if mode == "" {
+			continue
+		}
+
+		dueAt, err = digestDueAt(mode, deliverAt, timezone)
+		if err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("invalid timezone of recipient %s: %w", recipientID, err)
+		}
+
+		recipientIDs = append(recipientIDs, recipientID)
+		itemRows = append(itemRows, []any{
+			uuid.NewString(), systemID, userID, notificationID, createdAt, recipientID, content, dueAt, createdAt,
+		})
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to iterate digest recipients: %w", err)
+	}
+
+	if len(recipientIDs) == 0 {
+		return 0, nil
+	}
+
+	if err = copyDigestItems(ctx, tx, itemRows); err != nil {
+		return 0, err
+	}
+
+	updateQuery := r.sb.
+		Update("notification_recipients").
+		Set("status", deliveryStatusDigested).
+		Where(sq.Eq{"notification_id": notificationID, "created_at": createdAt}).
+		Where("id = ANY(?::uuid[])", recipientIDs)
+
+	updateSql, updateArgs, err := updateQuery.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build digest recipients update query: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, updateSql, updateArgs...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark recipients as digested: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func copyDigestItems(ctx context.Context, tx pgx.Tx, rows [][]any) error {
+	return copyRows(ctx, tx, "digest_items", []string{
+		"id", "system_id", "user_id", "notification_id", "notification_created_at", "recipient_id", "content",
+		"due_at", "created_at",
+	}, rows)
+}
+
+// dropPendingDigestItems takes the recipients of the notifications out of the
+// digests they are waiting for and cancels them. Recipients already rolled up
+// into a digest are kept.
+func (r *postgresRep) dropPendingDigestItems(
+	ctx context.Context,
+	tx pgx.Tx,
+	notifications []notificationKey,
+	now time.Time,
+) error {
+	notificationIDs, createdAts := splitNotificationKeys(notifications)
+
+	deleteQuery := r.sb.
+		Delete("digest_items").
+		Where("notification_id = ANY(?::uuid[])", notificationIDs).
+		Where(sq.Eq{"digest_notification_id": nil}).
+		Suffix("RETURNING recipient_id")
+
+	deleteSql, deleteArgs, err := deleteQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build drop digest items query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, deleteSql, deleteArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to drop digest items: %w", err)
+	}
+
+	recipientIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return fmt.Errorf("failed to collect dropped digest items: %w", err)
+	}
+
+	if len(recipientIDs) == 0 {
+		return nil
+	}
+
+	cancelQuery := r.sb.
+		Update("notification_recipients").
+		Set("status", deliveryStatusCancelled).
+		Set("updated_at", now).
+		Where("notification_id = ANY(?::uuid[])", notificationIDs).
+		Where("created_at = ANY(?::timestamptz[])", createdAts).
+		Where("id = ANY(?::uuid[])", recipientIDs)
+
+	cancelSql, cancelArgs, err := cancelQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build cancel digested recipients query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, cancelSql, cancelArgs...); err != nil {
+		return fmt.Errorf("failed to cancel digested recipients: %w", err)
+	}
+
+	return nil
+}
+
+// digestItem is a due digest item claimed by RollupDigests.
+type digestItem struct {
+	id       string
+	systemID string
+	userID   string
+	content  string
+}
+
+// RollupDigests rolls the due digest items of up to limit users into one
+// digest notification per user and enqueues them for dispatch. Digests are
+// rendered from the system template named templateName, or a built-in body
+// when the system has none. Items are claimed with SKIP LOCKED, so concurrent
+// schedulers pick disjoint users.
+func (r *postgresRep) RollupDigests(ctx context.Context, now time.Time, templateName string, limit int) (int, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	users, err := r.claimDigestItems(ctx, tx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(users) == 0 {
+		_ = tx.Rollback(ctx)
+
+		return 0, nil
+	}
+
+	bodies := make(map[string]string)
+
+	for _, items := range users {
+		systemID := items[0].systemID
+
+		body, ok := bodies[systemID]
+		if !ok {
+			if body, err = r.digestBody(ctx, tx, systemID, templateName); err != nil {
+				return 0, err
+			}
+
+			bodies[systemID] = body
+		}
+
+		if err = r.createDigest(ctx, tx, now, body, items); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(users), nil
+}
+
+// claimDigestItems locks the due items of up to limit users, the ones waiting
+// longest first, and returns them grouped by user in creation order.
+func (r *postgresRep) claimDigestItems(ctx context.Context, tx pgx.Tx, now time.Time, limit int) ([][]digestItem, error) {
+	dueUsers := r.sb.
+		Select("user_id").
+		From("digest_items").
+		Where(sq.Eq{"digest_notification_id": nil}).
+		Where(sq.LtOrEq{"due_at": now}).
+		GroupBy("user_id").
+		OrderBy("MIN(due_at)").
+		Limit(uint64(limit))
+
+	dueUsersSql, dueUsersArgs, err := dueUsers.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build due digest users query: %w", err)
+	}
+
+	claimQuery := r.sb.
+		Select("id", "system_id", "user_id", "content").
+		From("digest_items").
+		Where(sq.Eq{"digest_notification_id": nil}).
+		Where(sq.LtOrEq{"due_at": now}).
+		Where("user_id IN ("+dueUsersSql+")", dueUsersArgs...).
+		OrderBy("user_id", "created_at").
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	claimSql, claimArgs, err := claimQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build claim digest items query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, claimSql, claimArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim digest items: %w", err)
+	}
+	defer rows.Close()
+
+	var users [][]digestItem
+
+	for rows.Next() {
+		var item digestItem
+		if err = rows.Scan(&item.id, &item.systemID, &item.userID, &item.content); err != nil {
+			return nil, fmt.Errorf("failed to scan digest item: %w", err)
+		}
+
+		if len(users) == 0 || users[len(users)-1][0].userID != item.userID {
+			users = append(users, nil)
+		}
+
+		users[len(users)-1] = append(users[len(users)-1], item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate digest items: %w", err)
+	}
+
+	return users, nil
+}
+
+// digestBody returns the body of the digest template of the system, or the
+// built-in one when the system has no such template.
+func (r *postgresRep) digestBody(ctx context.Context, tx pgx.Tx, systemID, templateName string) (string, error) {
+	query := r.sb.
+		Select("body").
+		From("notification_templates").
+		Where(sq.Eq{"system_id": systemID, "name": templateName})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return "", fmt.Errorf("failed to build digest template query: %w", err)
+	}
+
+	var body string
+
+	if err = tx.QueryRow(ctx, sqlStr, args...).Scan(&body); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return defaultDigestBody, nil
+		}
+
+		return "", fmt.Errorf("failed to load digest template: %w", err)
+	}
+
+	return body, nil
+}
+
+// createDigest stores the digest notification of the items of one user, links
+// the items to it and enqueues it for dispatch.
+func (r *postgresRep) createDigest(ctx context.Context, tx pgx.Tx, now time.Time, body string, items []digestItem) error {
+	var (
+		systemID = items[0].systemID
+		userID   = items[0].userID
+		lines    = make([]string, 0, len(items))
+		itemIDs  = make([]string, 0, len(items))
+	)
+
+	for _, item := range items {
+		lines = append(lines, "- "+item.content)
+		itemIDs = append(itemIDs, item.id)
+	}
+
+	vars := map[string]string{
+		"count": strconv.Itoa(len(items)),
+		"items": strings.Join(lines, "\n"),
+	}
+
+	content, err := templating.Render(body, vars)
+	if err != nil {
+		// A template that cannot be rendered must not hold digests back forever.
+		slog.Warn("failed to render digest template, using the default one", slog.String("system_id", systemID),
+			slog.Any("error", err))
+
+		if content, err = templating.Render(defaultDigestBody, vars); err != nil {
+			return fmt.Errorf("failed to render digest: %w", err)
+		}
+	}
+
+	key := newNotificationKey(now)
+
+	notificationQuery := r.sb.
+		Insert("notifications").
+		Columns("id", "system_id", "content", "status", "created_at").
+		Values(key.ID, systemID, content, notificationStatusPending, key.CreatedAt)
+
+	notificationSql, notificationArgs, err := notificationQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert digest notification query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, notificationSql, notificationArgs...); err != nil {
+		return fmt.Errorf("failed to insert digest notification: %w", err)
+	}
+
+	recipientQuery := r.sb.
+		Insert("notification_recipients").
+		Columns("notification_id", "user_id", "created_at", "updated_at").
+		Values(key.ID, userID, key.CreatedAt, now)
+
+	recipientSql, recipientArgs, err := recipientQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert digest recipient query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, recipientSql, recipientArgs...); err != nil {
+		return fmt.Errorf("failed to insert digest recipient: %w", err)
+	}
+
+	linkQuery := r.sb.
+		Update("digest_items").
+		Set("digest_notification_id", key.ID).
+		Where("id = ANY(?::uuid[])", itemIDs)
+
+	linkSql, linkArgs, err := linkQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build link digest items query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, linkSql, linkArgs...); err != nil {
+		return fmt.Errorf("failed to link digest items: %w", err)
+	}
+
+	if err = r.deferQuietRecipients(ctx, tx, key.ID, key.CreatedAt, now); err != nil {
+		return err
+	}
+
+	return r.enqueueDispatch(ctx, tx, key.ID, key.CreatedAt)
+}
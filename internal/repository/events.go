@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+// notificationEventsChannel is the NOTIFY channel the notification_events
+// triggers publish to.
+const notificationEventsChannel = "notification_events"
+
+type EventRepository interface {
+	SequenceNotificationEvents(ctx context.Context, limit int) (int, error)
+	LastNotificationEventPosition(ctx context.Context) (int64, error)
+	ListNotificationEvents(
+		ctx context.Context,
+		filter NotificationEventFilter,
+		afterPosition int64,
+		limit int,
+	) ([]*rpcv1.NotificationEvent, error)
+	ListenNotificationEvents(ctx context.Context, wake func()) error
+	DeleteNotificationEvents(ctx context.Context, olderThan time.Time) (int64, error)
+}
+
+// NotificationEventFilter selects the events of one notification or of every
+// notification of a system.
+type NotificationEventFilter struct {
+	NotificationID string
+	SystemID       string
+}
+
+// Matches reports whether the event passes the filter.
+func (f NotificationEventFilter) Matches(event *rpcv1.NotificationEvent) bool {
+	if f.NotificationID != "" && event.GetNotificationId() != f.NotificationID {
+		return false
+	}
+
+	return f.SystemID == "" || event.GetSystemId() == f.SystemID
+}
+
+// notificationEventRecord is a sequenced notification_events row.
+type notificationEventRecord struct {
+	Position       int64
+	NotificationID string
+	SystemID       string
+	RecipientID    string
+	UserID         string
+	Status         string
+	CreatedAt      int64
+}
+
+func (e notificationEventRecord) toProto() *rpcv1.NotificationEvent {
+	event := &rpcv1.NotificationEvent{
+		EventId:        e.Position,
+		NotificationId: e.NotificationID,
+		SystemId:       e.SystemID,
+		RecipientId:    e.RecipientID,
+		UserId:         e.UserID,
+		OccurredAt:     e.CreatedAt,
+	}
+
+	if e.RecipientID != "" {
+		event.RecipientStatus = deliveryStatusFromDB(e.Status)
+	} else {
+		event.Status = notificationStatusFromDB(e.Status)
+	}
+
+	return event
+}
+
+// SequenceNotificationEvents assigns positions to up to limit committed
+// events that have none yet, in the order they were stored, and returns how
+// many it assigned. Sequencers are serialized by the lock on the position
+// counter, so a position becomes visible only after every lower one.
+func (r *postgresRep) SequenceNotificationEvents(ctx context.Context, limit int) (int, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	// The events are selected by a statement started after the lock is taken,
+	// so it sees everything committed before the previous sequencer finished.
+	var lastPosition int64
+	if err = tx.QueryRow(ctx, "SELECT last_position FROM notification_event_positions FOR UPDATE").Scan(&lastPosition); err != nil {
+		return 0, fmt.Errorf("failed to lock notification event positions: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE notification_events e
+		SET position = $1 + pending.n
+		FROM (
+			SELECT id, row_number() OVER (ORDER BY id) AS n
+			FROM (
+				SELECT id FROM notification_events WHERE position IS NULL ORDER BY id LIMIT $2
+			) unsequenced
+		) pending
+		WHERE e.id = pending.id`,
+		lastPosition, limit,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sequence notification events: %w", err)
+	}
+
+	sequenced := int(tag.RowsAffected())
+	if sequenced == 0 {
+		_ = tx.Rollback(ctx)
+
+		return 0, nil
+	}
+
+	if _, err = tx.Exec(
+		ctx, "UPDATE notification_event_positions SET last_position = $1", lastPosition+int64(sequenced),
+	); err != nil {
+		return 0, fmt.Errorf("failed to update notification event positions: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return sequenced, nil
+}
+
+// LastNotificationEventPosition returns the highest position assigned so far.
+func (r *postgresRep) LastNotificationEventPosition(ctx context.Context) (int64, error) {
+	var lastPosition int64
+	if err := r.pool.QueryRow(ctx, "SELECT last_position FROM notification_event_positions").Scan(&lastPosition); err != nil {
+		return 0, fmt.Errorf("failed to get last notification event position: %w", err)
+	}
+
+	return lastPosition, nil
+}
+
+// ListNotificationEvents returns up to limit events matching the filter that
+// were sequenced after afterPosition, in position order. Events that have not
+// been sequenced yet are left out.
+func (r *postgresRep) ListNotificationEvents(
+	ctx context.Context,
+	filter NotificationEventFilter,
+	afterPosition int64,
+	limit int,
+) ([]*rpcv1.NotificationEvent, error) {
+	query := r.sb.
+		Select("position", "notification_id", "system_id", "recipient_id", "user_id", "status", "created_at").
+		From("notification_events").
+		Where(sq.Gt{"position": afterPosition}).
+		OrderBy("position").
+		Limit(uint64(limit))
+
+	if filter.NotificationID != "" {
+		query = query.Where(sq.Eq{"notification_id": filter.NotificationID})
+	}
+
+	if filter.SystemID != "" {
+		query = query.Where(sq.Eq{"system_id": filter.SystemID})
+	}
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build list notification events query: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*rpcv1.NotificationEvent
+
+	for rows.Next() {
+		var (
+			record      notificationEventRecord
+			recipientID sql.NullString
+			userID      sql.NullString
+			createdAt   time.Time
+		)
+
+		if err = rows.Scan(
+			&record.Position, &record.NotificationID, &record.SystemID,
+			&recipientID, &userID, &record.Status, &createdAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan notification event: %w", err)
+		}
+
+		record.RecipientID = recipientID.String
+		record.UserID = userID.String
+		record.CreatedAt = createdAt.Unix()
+		events = append(events, record.toProto())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate notification events: %w", err)
+	}
+
+	return events, nil
+}
+
+// ListenNotificationEvents takes a connection out of the pool, listens for
+// new notification events on it and calls wake for each of them until ctx is
+// canceled or the connection fails. wake is also called once the listener is
+// set up, to pick up events stored while nobody was listening. It runs on the
+// listening goroutine and must not block.
+func (r *postgresRep) ListenNotificationEvents(ctx context.Context, wake func()) error {
+	pooled, err := r.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire listener connection: %w", err)
+	}
+
+	// A listening connection must not go back to the pool, other users of it
+	// would not expect notifications.
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err = conn.Exec(ctx, "LISTEN "+notificationEventsChannel); err != nil {
+		return fmt.Errorf("failed to listen for notification events: %w", err)
+	}
+
+	wake()
+
+	for {
+		if _, err = conn.WaitForNotification(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("failed to wait for notification events: %w", err)
+		}
+
+		wake()
+	}
+}
+
+// DeleteNotificationEvents removes events stored before olderThan. Watchers
+// cannot resume from cursors older than that anymore.
+func (r *postgresRep) DeleteNotificationEvents(ctx context.Context, olderThan time.Time) (int64, error) {
+	query := r.sb.
+		Delete("notification_events").
+		Where(sq.Lt{"created_at": olderThan})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build delete notification events query: %w", err)
+	}
+
+	tag, err := r.pool.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete notification events: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
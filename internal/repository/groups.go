@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+const groupColumns = "id, system_id, name, created_at, updated_at"
+
+type GroupRepository interface {
+	CreateGroup(ctx context.Context, systemID, name string) (*rpcv1.Group, error)
+	DeleteGroup(ctx context.Context, id string) error
+	AddGroupMembers(ctx context.Context, groupID string, userIDs []string) (*GroupMembersResult, error)
+	RemoveGroupMembers(ctx context.Context, groupID string, userIDs []string) (*GroupMembersResult, error)
+}
+
+// GroupMembersResult reports which requested ids at system a membership change
+// was applied to.
+type GroupMembersResult struct {
+	Resolved   []string
+	Unresolved []string
+}
+
+func (r *postgresRep) CreateGroup(ctx context.Context, systemID, name string) (*rpcv1.Group, error) {
+	now := time.Now().UTC()
+
+	query := r.sb.
+		Insert("user_groups").
+		Columns("system_id", "name", "created_at", "updated_at").
+		Values(systemID, name, now, now).
+		Suffix("RETURNING " + groupColumns)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := scanGroup(r.pool.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, apperrors.NewConflictError("group already exists", "group "+name+" already exists in the system")
+		}
+
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (r *postgresRep) DeleteGroup(ctx context.Context, id string) error {
+	query := r.sb.
+		Delete("user_groups").
+		Where(sq.Eq{"id": id})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.pool.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return groupNotFound(id)
+	}
+
+	return nil
+}
+
+// AddGroupMembers adds users of the group's system to the group. Users that
+// are already members are left as they are.
+func (r *postgresRep) AddGroupMembers(
+	ctx context.Context,
+	groupID string,
+	userIDs []string,
+) (*GroupMembersResult, error) {
+	return r.changeGroupMembers(ctx, groupID, userIDs, func(tx pgx.Tx, userUUIDs []string) error {
+		query := r.sb.
+			Insert("user_group_members").
+			Columns("group_id", "user_id").
+			Select(r.sb.Select().Column("?::uuid", groupID).Column("unnest(?::uuid[])", userUUIDs)).
+			Suffix("ON CONFLICT (group_id, user_id) DO NOTHING")
+
+		sqlStr, args, err := query.ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build insert group members query: %w", err)
+		}
+
+		if _, err = tx.Exec(ctx, sqlStr, args...); err != nil {
+			return fmt.Errorf("failed to insert group members: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *postgresRep) RemoveGroupMembers(
+	ctx context.Context,
+	groupID string,
+	userIDs []string,
+) (*GroupMembersResult, error) {
+	return r.changeGroupMembers(ctx, groupID, userIDs, func(tx pgx.Tx, userUUIDs []string) error {
+		query := r.sb.
+			Delete("user_group_members").
+			Where(sq.Eq{"group_id": groupID}).
+			Where("user_id = ANY(?::uuid[])", userUUIDs)
+
+		sqlStr, args, err := query.ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build delete group members query: %w", err)
+		}
+
+		if _, err = tx.Exec(ctx, sqlStr, args...); err != nil {
+			return fmt.Errorf("failed to delete group members: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// changeGroupMembers resolves userIDs within the system of the group and
+// applies change to the users found. The group row is locked, so a concurrent
+// DeleteGroup cannot race the change.
+func (r *postgresRep) changeGroupMembers(
+	ctx context.Context,
+	groupID string,
+	userIDs []string,
+	change func(tx pgx.Tx, userUUIDs []string) error,
+) (*GroupMembersResult, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	groupQuery := r.sb.
+		Select("system_id").
+		From("user_groups").
+		Where(sq.Eq{"id": groupID}).
+		Suffix("FOR UPDATE")
+
+	groupSql, groupArgs, err := groupQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build group query: %w", err)
+	}
+
+	var systemID string
+
+	if err = tx.QueryRow(ctx, groupSql, groupArgs...).Scan(&systemID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = groupNotFound(groupID)
+
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to load group: %w", err)
+	}
+
+	userUUIDs, found, err := r.resolveUsers(ctx, tx, systemID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GroupMembersResult{}
+	result.Resolved, result.Unresolved = splitResolved(userIDs, found)
+
+	if len(userUUIDs) > 0 {
+		if err = change(tx, userUUIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+// checkGroups makes sure every group exists within the system.
+func (r *postgresRep) checkGroups(ctx context.Context, tx pgx.Tx, systemID string, groupIDs []string) error {
+	query := r.sb.
+		Select("id").
+		From("user_groups").
+		Where(sq.Eq{"system_id": systemID}).
+		Where("id = ANY(?::uuid[])", groupIDs)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build groups query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return fmt.Errorf("failed to load groups: %w", err)
+	}
+	defer rows.Close()
+
+	found := make(map[string]struct{}, len(groupIDs))
+
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return fmt.Errorf("failed to scan group id: %w", err)
+		}
+
+		found[id] = struct{}{}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate group rows: %w", err)
+	}
+
+	_, missing := splitResolved(groupIDs, found)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	details := make([]string, 0, len(missing))
+	for _, id := range missing {
+		details = append(details, "group "+id+" not found in system "+systemID)
+	}
+
+	return apperrors.NewNotFoundError("groups not found", details...)
+}
+
+func scanGroup(row pgx.Row) (*rpcv1.Group, error) {
+	var (
+		id        string
+		systemID  string
+		name      string
+		createdAt time.Time
+		updatedAt time.Time
+	)
+
+	if err := row.Scan(&id, &systemID, &name, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	return &rpcv1.Group{
+		Id:        id,
+		SystemId:  systemID,
+		Name:      name,
+		CreatedAt: createdAt.Unix(),
+		UpdatedAt: updatedAt.Unix(),
+	}, nil
+}
+
+func groupNotFound(id string) error {
+	return apperrors.NewNotFoundError("group not found", "group "+id+" does not exist")
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+)
+
+// claimIdempotencyKey reserves the idempotency key of params for the current
+// transaction. An expired key is taken over as if it never existed. When the key
+// is still live, it reports claimed=false together with the notification created
+// by the original request, or a ConflictError if the payloads differ.
+func (r *postgresRep) claimIdempotencyKey(
+	ctx context.Context,
+	tx pgx.Tx,
+	params CreateNotificationParams,
+) (string, bool, error) {
+	now := time.Now().UTC()
+
+	// Concurrent claims of the same key block on the row lock here until the
+	// first transaction finishes, so the lookup below sees its outcome. The key
+	// is bound to its notification in that same transaction, a live key always
+	// has one.
+	claimQuery := r.sb.
+		Insert("notification_idempotency_keys").
+		Columns("system_id", "idempotency_key", "request_hash", "created_at", "expires_at").
+		Values(params.SystemID, params.IdempotencyKey, params.RequestHash, now, now.Add(r.idempotencyKeyTTL)).
+		Suffix(`ON CONFLICT (system_id, idempotency_key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			notification_id = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE notification_idempotency_keys.expires_at <= EXCLUDED.created_at
+		RETURNING idempotency_key`)
+
+	claimSql, claimArgs, err := claimQuery.ToSql()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to build claim idempotency key query: %w", err)
+	}
+
+	var claimedKey string
+
+	err = tx.QueryRow(ctx, claimSql, claimArgs...).Scan(&claimedKey)
+	if err == nil {
+		return "", true, nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return "", false, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+
+	lookupQuery := r.sb.
+		Select("request_hash", "notification_id").
+		From("notification_idempotency_keys").
+		Where(sq.Eq{
+			"system_id":       params.SystemID,
+			"idempotency_key": params.IdempotencyKey,
+		})
+
+	lookupSql, lookupArgs, err := lookupQuery.ToSql()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to build idempotency key lookup query: %w", err)
+	}
+
+	var requestHash, notificationID string
+
+	if err = tx.QueryRow(ctx, lookupSql, lookupArgs...).Scan(&requestHash, &notificationID); err != nil {
+		return "", false, fmt.Errorf("failed to lookup idempotency key: %w", err)
+	}
+
+	if requestHash != params.RequestHash {
+		return "", false, apperrors.NewConflictError(
+			"idempotency key already used",
+			"idempotency_key "+params.IdempotencyKey+" was used with a different request payload",
+		)
+	}
+
+	return notificationID, false, nil
+}
+
+func (r *postgresRep) bindIdempotencyKey(
+	ctx context.Context,
+	tx pgx.Tx,
+	systemID,
+	idempotencyKey,
+	notificationID string,
+) error {
+	query := r.sb.
+		Update("notification_idempotency_keys").
+		Set("notification_id", notificationID).
+		Where(sq.Eq{
+			"system_id":       systemID,
+			"idempotency_key": idempotencyKey,
+		})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build bind idempotency key query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlStr, args...); err != nil {
+		return fmt.Errorf("failed to bind idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRep) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	query := r.sb.
+		Delete("notification_idempotency_keys").
+		Where(sq.LtOrEq{"expires_at": now})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := r.pool.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
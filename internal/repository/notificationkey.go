@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"encoding/binary"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// notificationKey is the primary key of a notification. Notifications and
+// their recipients are partitioned by created_at, lookups that carry it touch
+// a single partition instead of probing every one.
+type notificationKey struct {
+	ID        string
+	CreatedAt time.Time // zero when unknown
+}
+
+// newNotificationKey mints the key of a notification created at createdAt.
+// The id is a version 7 UUID whose timestamp is createdAt, which is truncated
+// to the millisecond precision of the UUID, so the key can be recovered from
+// the id alone.
+func newNotificationKey(createdAt time.Time) notificationKey {
+	createdAt = createdAt.UTC().Truncate(time.Millisecond)
+
+	id := uuid.Must(uuid.NewV7())
+
+	var millis [8]byte
+
+	binary.BigEndian.PutUint64(millis[:], uint64(createdAt.UnixMilli()))
+	copy(id[:6], millis[2:])
+
+	return notificationKey{ID: id.String(), CreatedAt: createdAt}
+}
+
+// notificationKeyOf recovers the key of a notification from its id. Ids
+// minted before newNotificationKey existed are random and leave CreatedAt
+// unknown.
+func notificationKeyOf(id string) notificationKey {
+	key := notificationKey{ID: id}
+
+	parsed, err := uuid.Parse(id)
+	if err != nil || parsed.Version() != 7 {
+		return key
+	}
+
+	var millis [8]byte
+
+	copy(millis[2:], parsed[:6])
+	key.CreatedAt = time.UnixMilli(int64(binary.BigEndian.Uint64(millis[:]))).UTC()
+
+	return key
+}
+
+// withCreatedAt fills in a created_at learned elsewhere, e.g. from a message
+// payload, when the id does not carry it.
+func (k notificationKey) withCreatedAt(createdAt time.Time) notificationKey {
+	if k.CreatedAt.IsZero() {
+		k.CreatedAt = createdAt
+	}
+
+	return k
+}
+
+// eq matches the rows of the notification by the given id and created_at
+// columns, e.g. "id" and "created_at" of notifications or "notification_id"
+// and "created_at" of its recipients.
+func (k notificationKey) eq(idColumn, createdAtColumn string) sq.Eq {
+	if k.CreatedAt.IsZero() {
+		return sq.Eq{idColumn: k.ID}
+	}
+
+	return sq.Eq{idColumn: k.ID, createdAtColumn: k.CreatedAt}
+}
+
+// splitNotificationKeys returns the ids and created_at values of the keys, to
+// be passed as array parameters.
+func splitNotificationKeys(keys []notificationKey) ([]string, []time.Time) {
+	ids := make([]string, 0, len(keys))
+	createdAts := make([]time.Time, 0, len(keys))
+
+	for _, key := range keys {
+		ids = append(ids, key.ID)
+		createdAts = append(createdAts, key.CreatedAt)
+	}
+
+	return ids, createdAts
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNotificationKeyOf(t *testing.T) {
+	t.Parallel()
+
+	minted := newNotificationKey(time.Date(2025, 1, 28, 12, 30, 15, 123456789, time.FixedZone("CET", 3600)))
+
+	tests := []struct {
+		name          string
+		id            string
+		wantCreatedAt time.Time
+	}{
+		{
+			name:          "minted id",
+			id:            minted.ID,
+			wantCreatedAt: time.Date(2025, 1, 28, 11, 30, 15, 123000000, time.UTC),
+		},
+		{
+			name: "random id",
+			id:   "8f7b5c2e-4a1d-4e6f-9b3a-2c1d0e9f8a7b",
+		},
+		{
+			name: "malformed id",
+			id:   "not-a-uuid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			key := notificationKeyOf(tt.id)
+			if key.ID != tt.id || !key.CreatedAt.Equal(tt.wantCreatedAt) {
+				t.Errorf("notificationKeyOf(%q) = %+v, want created_at %s", tt.id, key, tt.wantCreatedAt)
+			}
+		})
+	}
+
+	if !minted.CreatedAt.Equal(notificationKeyOf(minted.ID).CreatedAt) {
+		t.Errorf("minted key %+v does not round trip", minted)
+	}
+}
+
+func TestNotificationKeyEq(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2025, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		key      notificationKey
+		wantSQL  string
+		wantArgs int
+	}{
+		{
+			name:     "known created_at",
+			key:      notificationKey{ID: "id", CreatedAt: createdAt},
+			wantSQL:  "r.created_at = ? AND r.notification_id = ?",
+			wantArgs: 2,
+		},
+		{
+			name:     "unknown created_at",
+			key:      notificationKey{ID: "id"},
+			wantSQL:  "r.notification_id = ?",
+			wantArgs: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlStr, args, err := tt.key.eq("r.notification_id", "r.created_at").ToSql()
+			if err != nil {
+				t.Fatalf("ToSql() error = %v", err)
+			}
+
+			if sqlStr != tt.wantSQL || len(args) != tt.wantArgs {
+				t.Errorf("eq() = %q with %d args, want %q with %d", sqlStr, len(args), tt.wantSQL, tt.wantArgs)
+			}
+		})
+	}
+}
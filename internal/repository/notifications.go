@@ -0,0 +1,452 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+const (
+	notificationStatusPending         = "pending"
+	notificationStatusSent            = "sent"
+	notificationStatusScheduled       = "scheduled"
+	notificationStatusCancelled       = "cancelled"
+	notificationStatusQueued          = "queued"
+	notificationStatusSending         = "sending"
+	notificationStatusPartiallyFailed = "partially_failed"
+	notificationStatusFailed          = "failed"
+	notificationStatusExpired         = "expired"
+)
+
+// NotificationFilter narrows down ListNotifications. Zero values mean "no filter".
+type NotificationFilter struct {
+	SystemID            string
+	Status              rpcv1.NotificationStatus
+	CreatedFrom         time.Time
+	CreatedTo           time.Time
+	RecipientIDAtSystem string
+	Limit               int
+	Cursor              string
+}
+
+func notificationStatusToDB(status rpcv1.NotificationStatus) string {
+	switch status {
+	case rpcv1.NotificationStatus_NOTIFICATION_STATUS_PENDING:
+		return notificationStatusPending
+	case rpcv1.NotificationStatus_NOTIFICATION_STATUS_SENT:
+		return notificationStatusSent
+	case rpcv1.NotificationStatus_NOTIFICATION_STATUS_SCHEDULED:
+		return notificationStatusScheduled
+	case rpcv1.NotificationStatus_NOTIFICATION_STATUS_CANCELLED:
+		return notificationStatusCancelled
+	case rpcv1.NotificationStatus_NOTIFICATION_STATUS_QUEUED:
+		return notificationStatusQueued
+	case rpcv1.NotificationStatus_NOTIFICATION_STATUS_SENDING:
+		return notificationStatusSending
+	case rpcv1.NotificationStatus_NOTIFICATION_STATUS_PARTIALLY_FAILED:
+		return notificationStatusPartiallyFailed
+	case rpcv1.NotificationStatus_NOTIFICATION_STATUS_FAILED:
+		return notificationStatusFailed
+	case rpcv1.NotificationStatus_NOTIFICATION_STATUS_EXPIRED:
+		return notificationStatusExpired
+	default:
+		return ""
+	}
+}
+
+func notificationStatusFromDB(status string) rpcv1.NotificationStatus {
+	switch status {
+	case notificationStatusPending:
+		return rpcv1.NotificationStatus_NOTIFICATION_STATUS_PENDING
+	case notificationStatusSent:
+		return rpcv1.NotificationStatus_NOTIFICATION_STATUS_SENT
+	case notificationStatusScheduled:
+		return rpcv1.NotificationStatus_NOTIFICATION_STATUS_SCHEDULED
+	case notificationStatusCancelled:
+		return rpcv1.NotificationStatus_NOTIFICATION_STATUS_CANCELLED
+	case notificationStatusQueued:
+		return rpcv1.NotificationStatus_NOTIFICATION_STATUS_QUEUED
+	case notificationStatusSending:
+		return rpcv1.NotificationStatus_NOTIFICATION_STATUS_SENDING
+	case notificationStatusPartiallyFailed:
+		return rpcv1.NotificationStatus_NOTIFICATION_STATUS_PARTIALLY_FAILED
+	case notificationStatusFailed:
+		return rpcv1.NotificationStatus_NOTIFICATION_STATUS_FAILED
+	case notificationStatusExpired:
+		return rpcv1.NotificationStatus_NOTIFICATION_STATUS_EXPIRED
+	default:
+		return rpcv1.NotificationStatus_NOTIFICATION_STATUS_UNSPECIFIED
+	}
+}
+
+func (r *postgresRep) GetNotification(ctx context.Context, id string) (*rpcv1.Notification, error) {
+	query := r.sb.
+		Select("id", "system_id", "content", "content_variants", "status", "created_at", "send_at", "category").
+		From("notifications").
+		Where(notificationKeyOf(id).eq("id", "created_at"))
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	notification, createdAt, err := scanNotification(r.pool.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.NewNotFoundError("notification not found", "notification "+id+" does not exist")
+		}
+
+		return nil, err
+	}
+
+	key := notificationKey{ID: id, CreatedAt: createdAt}
+
+	recipients, err := r.listRecipients(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := r.listRecipientDeliveries(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*rpcv1.Recipient, len(recipients))
+	for _, recipient := range recipients {
+		byID[recipient.GetId()] = recipient
+	}
+
+	for _, delivery := range deliveries {
+		if recipient, ok := byID[delivery.GetRecipientId()]; ok {
+			recipient.Deliveries = append(recipient.Deliveries, delivery)
+		}
+	}
+
+	notification.Recipients = recipients
+
+	return notification, nil
+}
+
+// ListNotifications returns notifications newest first using keyset pagination
+// over (created_at, id). The returned cursor is empty on the last page.
+func (r *postgresRep) ListNotifications(
+	ctx context.Context,
+	filter NotificationFilter,
+) ([]*rpcv1.Notification, string, error) {
+	query := r.sb.
+		Select(
+			"n.id", "n.system_id", "n.content", "n.content_variants", "n.status", "n.created_at", "n.send_at", "n.category",
+		).
+		From("notifications n").
+		OrderBy("n.created_at DESC", "n.id DESC").
+		Limit(uint64(filter.Limit) + 1)
+
+	if filter.SystemID != "" {
+		query = query.Where(sq.Eq{"n.system_id": filter.SystemID})
+	}
+
+	if filter.Status != rpcv1.NotificationStatus_NOTIFICATION_STATUS_UNSPECIFIED {
+		query = query.Where(sq.Eq{"n.status": notificationStatusToDB(filter.Status)})
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		query = query.Where(sq.GtOrEq{"n.created_at": filter.CreatedFrom})
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		query = query.Where(sq.Lt{"n.created_at": filter.CreatedTo})
+	}
+
+	var (
+		cursorCreatedAt time.Time
+		cursorID        string
+	)
+
+	if filter.Cursor != "" {
+		var err error
+
+		cursorCreatedAt, cursorID, err = decodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		query = query.Where("(n.created_at, n.id) < (?::timestamptz, ?::uuid)", cursorCreatedAt, cursorID)
+	}
+
+	// Recipients share the created_at of their notification, the bounds on it
+	// are repeated on the recipients so both sides skip the same partitions.
+	if filter.RecipientIDAtSystem != "" {
+		recipientsQuery := r.sb.
+			Select("r.notification_id", "r.created_at").
+			From("notification_recipients r").
+			Join("users u ON u.id = r.user_id").
+			Where(sq.Eq{"u.id_at_system": filter.RecipientIDAtSystem})
+
+		if filter.SystemID != "" {
+			recipientsQuery = recipientsQuery.Where(sq.Eq{"u.system_id": filter.SystemID})
+		}
+
+		if !filter.CreatedFrom.IsZero() {
+			recipientsQuery = recipientsQuery.Where(sq.GtOrEq{"r.created_at": filter.CreatedFrom})
+		}
+
+		if !filter.CreatedTo.IsZero() {
+			recipientsQuery = recipientsQuery.Where(sq.Lt{"r.created_at": filter.CreatedTo})
+		}
+
+		if !cursorCreatedAt.IsZero() {
+			recipientsQuery = recipientsQuery.Where(sq.LtOrEq{"r.created_at": cursorCreatedAt})
+		}
+
+		recipientsSql, recipientsArgs, err := recipientsQuery.ToSql()
+		if err != nil {
+			return nil, "", err
+		}
+
+		query = query.Where("(n.id, n.created_at) IN ("+recipientsSql+")", recipientsArgs...)
+	}
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, "", err
+	}
+
+	rows, err := r.pool.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var (
+		notifications []*rpcv1.Notification
+		createdAts    []time.Time
+	)
+
+	for rows.Next() {
+		notification, createdAt, err := scanNotification(rows)
+		if err != nil {
+			return nil, "", err
+		}
+
+		notifications = append(notifications, notification)
+		createdAts = append(createdAts, createdAt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(notifications) <= filter.Limit {
+		return notifications, "", nil
+	}
+
+	notifications = notifications[:filter.Limit]
+	last := len(notifications) - 1
+
+	return notifications, encodeCursor(createdAts[last], notifications[last].GetId()), nil
+}
+
+func (r *postgresRep) listRecipients(ctx context.Context, notification notificationKey) ([]*rpcv1.Recipient, error) {
+	query := r.sb.
+		Select("r.id", "r.user_id", "u.id_at_system", "r.status", "r.status_reason", "d.digest_notification_id").
+		From("notification_recipients r").
+		Join("users u ON u.id = r.user_id").
+		LeftJoin("digest_items d ON d.recipient_id = r.id").
+		Where(notification.eq("r.notification_id", "r.created_at")).
+		OrderBy("u.id_at_system")
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var recipients []*rpcv1.Recipient
+
+	for rows.Next() {
+		var (
+			id         string
+			userID     string
+			idAtSystem string
+			status     string
+			reason     sql.NullString
+			digestID   sql.NullString
+		)
+
+		if err := rows.Scan(&id, &userID, &idAtSystem, &status, &reason, &digestID); err != nil {
+			return nil, err
+		}
+
+		recipients = append(recipients, &rpcv1.Recipient{
+			Id:           id,
+			UserId:       userID,
+			IdAtSystem:   idAtSystem,
+			Status:       deliveryStatusFromDB(status),
+			StatusReason: reason.String,
+
+			DigestNotificationId: digestID.String,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return recipients, nil
+}
+
+// scanNotification also returns the raw created_at, since the unix seconds in
+// the message are too coarse to build a cursor from.
+func scanNotification(row pgx.Row) (*rpcv1.Notification, time.Time, error) {
+	var (
+		id        string
+		systemID  string
+		content   string
+		variants  []byte
+		status    string
+		createdAt time.Time
+		sendAt    sql.NullTime
+		category  sql.NullString
+	)
+
+	if err := row.Scan(&id, &systemID, &content, &variants, &status, &createdAt, &sendAt, &category); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	structured, err := contentToProto(content, variants)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	notification := &rpcv1.Notification{
+		Id:        id,
+		SystemId:  systemID,
+		Content:   content,
+		Status:    notificationStatusFromDB(status),
+		CreatedAt: createdAt.Unix(),
+		Category:  category.String,
+
+		StructuredContent: structured,
+	}
+
+	if sendAt.Valid {
+		notification.SendAt = sendAt.Time.Unix()
+	}
+
+	return notification, createdAt, nil
+}
+
+// encodeCursor builds an opaque page token from the last row of a page.
+func encodeCursor(createdAt time.Time, id string) string {
+	raw := strconv.FormatInt(createdAt.UnixNano(), 10) + "|" + id
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursor string) (time.Time, string, error) {
+	invalid := apperrors.NewValidationError("invalid page token", "page_token is malformed")
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", invalid
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), "|")
+	if !ok || uuid.Validate(id) != nil {
+		return time.Time{}, "", invalid
+	}
+
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, "", invalid
+	}
+
+	return time.Unix(0, unixNano).UTC(), id, nil
+}
+
+// replayNotificationResult rebuilds the result of an already stored
+// notification for a retried request.
+func (r *postgresRep) replayNotificationResult(
+	ctx context.Context,
+	notificationID string,
+	userIDs []string,
+) (*CreateNotificationResult, error) {
+	recipients, err := r.listRecipients(ctx, notificationKeyOf(notificationID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load recipients of notification %s: %w", notificationID, err)
+	}
+
+	result := &CreateNotificationResult{
+		NotificationID: notificationID,
+		RecipientCount: int64(len(recipients)),
+	}
+
+	found := make(map[string]struct{}, len(recipients))
+	for _, recipient := range recipients {
+		found[recipient.GetIdAtSystem()] = struct{}{}
+
+		switch recipient.GetStatus() {
+		case rpcv1.DeliveryStatus_DELIVERY_STATUS_DEDUPLICATED:
+			result.DeduplicatedCount++
+		case rpcv1.DeliveryStatus_DELIVERY_STATUS_SUPPRESSED:
+			result.SuppressedCount++
+		case rpcv1.DeliveryStatus_DELIVERY_STATUS_DIGESTED:
+			result.DigestedCount++
+		}
+	}
+	result.Resolved, result.Unresolved = splitResolved(userIDs, found)
+
+	return result, nil
+}
+
+// splitResolved partitions requested ids at system into found and missing ones,
+// keeping the request order and dropping duplicates.
+func splitResolved(requested []string, found map[string]struct{}) ([]string, []string) {
+	var (
+		resolved   []string
+		unresolved []string
+		seen       = make(map[string]struct{}, len(requested))
+	)
+
+	for _, id := range requested {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+
+		if _, ok := found[id]; ok {
+			resolved = append(resolved, id)
+		} else {
+			unresolved = append(unresolved, id)
+		}
+	}
+
+	return resolved, unresolved
+}
+
+func unresolvedRecipientsError(systemID string, unresolved []string) error {
+	details := make([]string, 0, len(unresolved))
+	for _, id := range unresolved {
+		details = append(details, "user "+id+" not found in system "+systemID)
+	}
+
+	return apperrors.NewNotFoundError("recipients not found", details...)
+}
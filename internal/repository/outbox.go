@@ -0,0 +1,386 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+)
+
+const (
+	EventNotificationCreated   = "notification.created"
+	EventNotificationCancelled = "notification.cancelled"
+	EventQuotaWarning          = "quota.warning"
+)
+
+type OutboxRepository interface {
+	ProcessOutbox(ctx context.Context, limit int, publish PublishFunc, backoff BackoffFunc) (int, error)
+	DeletePublishedOutboxEvents(ctx context.Context, olderThan time.Time) (int64, error)
+}
+
+// OutboxEvent is a message waiting to be published to the broker.
+type OutboxEvent struct {
+	ID          int64
+	AggregateID string
+	EventType   string
+	Payload     []byte
+	Attempts    int
+}
+
+// PublishFunc delivers a single outbox event to the broker.
+type PublishFunc func(ctx context.Context, event OutboxEvent) error
+
+// BackoffFunc returns the delay before the next publish attempt of an event
+// that has already failed attempt times.
+type BackoffFunc func(attempt int) time.Duration
+
+// maxDispatchRecipients bounds the recipients of a single notification.created
+// event, so broadcasts do not produce messages above the broker size limit.
+const maxDispatchRecipients = 1000
+
+// NotificationCreatedEvent is the payload published for every stored notification.
+// Notifications with more than maxDispatchRecipients recipients are published
+// as several events, Part counts from 1 to Parts and the parts are published in
+// order.
+type NotificationCreatedEvent struct {
+	NotificationID string              `json:"notification_id"`
+	SystemID       string              `json:"system_id"`
+	Content        string              `json:"content"`
+	Channels       ChannelContent      `json:"channels"`
+	CreatedAt      time.Time           `json:"created_at"`
+	Recipients     []DispatchRecipient `json:"recipients"`
+	Part           int                 `json:"part"`
+	Parts          int                 `json:"parts"`
+}
+
+// split divides the event into parts of at most maxDispatchRecipients
+// recipients. An event without recipients still makes one part.
+func (e NotificationCreatedEvent) split() []NotificationCreatedEvent {
+	recipients := e.Recipients
+	parts := max(1, (len(recipients)+maxDispatchRecipients-1)/maxDispatchRecipients)
+	events := make([]NotificationCreatedEvent, 0, parts)
+
+	for part := 1; part <= parts; part++ {
+		n := min(len(recipients), maxDispatchRecipients)
+
+		event := e
+		event.Recipients, recipients = recipients[:n:n], recipients[n:]
+		event.Part, event.Parts = part, parts
+		events = append(events, event)
+	}
+
+	return events
+}
+
+// NotificationCancelledEvent tells dispatchers to drop the listed recipients
+// of an already published notification.
+type NotificationCancelledEvent struct {
+	NotificationID string    `json:"notification_id"`
+	SystemID       string    `json:"system_id"`
+	RecipientIDs   []string  `json:"recipient_ids"`
+	CancelledAt    time.Time `json:"cancelled_at"`
+}
+
+type DispatchRecipient struct {
+	RecipientID    string `json:"recipient_id"`
+	UserID         string `json:"user_id"`
+	IDAtSystem     string `json:"id_at_system"`
+	Email          string `json:"email,omitempty"`
+	Phone          string `json:"phone,omitempty"`
+	TelegramChatID string `json:"telegram_chat_id,omitempty"`
+
+	// DeliverAfter holds the delivery back until the quiet hours of the user end.
+	DeliverAfter *time.Time `json:"deliver_after,omitempty"`
+}
+
+// enqueueDispatch writes the notification.created events of the notification
+// into the outbox within the caller's transaction.
+func (r *postgresRep) enqueueDispatch(
+	ctx context.Context,
+	tx pgx.Tx,
+	notificationID string,
+	createdAt time.Time,
+) error {
+	notifQuery := r.sb.
+		Select("system_id", "content", "content_variants", "created_at").
+		From("notifications").
+		Where(sq.Eq{"id": notificationID, "created_at": createdAt})
+
+	notifSql, notifArgs, err := notifQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build dispatch notification query: %w", err)
+	}
+
+	var (
+		event    = NotificationCreatedEvent{NotificationID: notificationID}
+		variants []byte
+	)
+
+	if err = tx.QueryRow(ctx, notifSql, notifArgs...).Scan(
+		&event.SystemID, &event.Content, &variants, &event.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("failed to load notification for dispatch: %w", err)
+	}
+
+	if event.Channels, err = resolveChannelContent(event.Content, variants); err != nil {
+		return fmt.Errorf("failed to resolve channel content: %w", err)
+	}
+
+	recipientsQuery := r.sb.
+		Select(
+			"r.id", "r.user_id", "u.id_at_system", "u.email", "u.phone", "u.telegram_chat_id", "r.deliver_after",
+			"p.disabled_channels",
+		).
+		From("notification_recipients r").
+		Join("users u ON u.id = r.user_id").
+		LeftJoin("user_preferences p ON p.user_id = r.user_id").
+		Where(sq.Eq{
+			"r.notification_id": notificationID,
+			"r.created_at":      createdAt,
+			"r.status":          deliveryStatusQueued,
+		})
+
+	recipientsSql, recipientsArgs, err := recipientsQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build dispatch recipients query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, recipientsSql, recipientsArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to load recipients for dispatch: %w", err)
+	}
+
+	for rows.Next() {
+		var (
+			recipient      DispatchRecipient
+			email          sql.NullString
+			phone          sql.NullString
+			telegramChatID sql.NullString
+			deliverAfter   sql.NullTime
+			preferences    recipientPreferences
+		)
+
+		if err = rows.Scan(
+			&recipient.RecipientID, &recipient.UserID, &recipient.IDAtSystem,
+			&email, &phone, &telegramChatID, &deliverAfter, &preferences.disabledChannels,
+		); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan dispatch recipient: %w", err)
+		}
+
+		recipient.Email = email.String
+		recipient.Phone = phone.String
+		recipient.TelegramChatID = telegramChatID.String
+
+		// Recipients ruled out entirely were suppressed when the notification
+		// was created, here only the addresses of disabled channels are dropped.
+		preferences.apply(&recipient, categoryPolicy{})
+
+		if deliverAfter.Valid {
+			recipient.DeliverAfter = &deliverAfter.Time
+		}
+
+		event.Recipients = append(event.Recipients, recipient)
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate dispatch recipients: %w", err)
+	}
+
+	for _, part := range event.split() {
+		if err = r.insertOutboxEvent(ctx, tx, notificationID, EventNotificationCreated, part); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *postgresRep) insertOutboxEvent(
+	ctx context.Context,
+	tx pgx.Tx,
+	aggregateID,
+	eventType string,
+	payload any,
+) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s event: %w", eventType, err)
+	}
+
+	now := time.Now().UTC()
+
+	query := r.sb.
+		Insert("outbox_events").
+		Columns("aggregate_id", "event_type", "payload", "next_attempt_at", "created_at").
+		Values(aggregateID, eventType, payloadBytes, now, now)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert outbox event query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlStr, args...); err != nil {
+		return fmt.Errorf("failed to insert outbox event: %w", err)
+	}
+
+	return nil
+}
+
+// ProcessOutbox locks a batch of due events, publishes them one by one and
+// records the outcome, returning the number of processed events. Rows stay
+// locked until the batch is committed, so several relays can run side by side.
+// An event is held back while an older event of the same aggregate is still
+// unpublished, which keeps per-notification ordering intact across retries.
+func (r *postgresRep) ProcessOutbox(
+	ctx context.Context,
+	limit int,
+	publish PublishFunc,
+	backoff BackoffFunc,
+) (int, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	now := time.Now().UTC()
+
+	claimQuery := r.sb.
+		Select("e.id", "e.aggregate_id", "e.event_type", "e.payload", "e.attempts").
+		From("outbox_events e").
+		Where(sq.Eq{"e.published_at": nil}).
+		Where(sq.LtOrEq{"e.next_attempt_at": now}).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM outbox_events prev
+			WHERE prev.aggregate_id = e.aggregate_id AND prev.published_at IS NULL AND prev.id < e.id
+		)`).
+		OrderBy("e.id").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	claimSql, claimArgs, err := claimQuery.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build claim outbox query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, claimSql, claimArgs...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+
+	var events []OutboxEvent
+
+	for rows.Next() {
+		var event OutboxEvent
+
+		if err = rows.Scan(&event.ID, &event.AggregateID, &event.EventType, &event.Payload, &event.Attempts); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to iterate outbox events: %w", err)
+	}
+
+	for _, event := range events {
+		update := r.sb.
+			Update("outbox_events").
+			Set("attempts", event.Attempts+1).
+			Where(sq.Eq{"id": event.ID})
+
+		if publishErr := publish(ctx, event); publishErr != nil {
+			update = update.
+				Set("last_error", publishErr.Error()).
+				Set("next_attempt_at", time.Now().UTC().Add(backoff(event.Attempts+1)))
+		} else {
+			update = update.Set("published_at", time.Now().UTC())
+
+			if err = r.markNotificationQueued(ctx, tx, event); err != nil {
+				return 0, err
+			}
+		}
+
+		updateSql, updateArgs, buildErr := update.ToSql()
+		if buildErr != nil {
+			err = fmt.Errorf("failed to build update outbox event query: %w", buildErr)
+			return 0, err
+		}
+
+		if _, err = tx.Exec(ctx, updateSql, updateArgs...); err != nil {
+			return 0, fmt.Errorf("failed to update outbox event: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(events), nil
+}
+
+// markNotificationQueued moves a notification to queued once the last part of
+// its notification.created event has reached the broker. Recipient reports may
+// have moved it further already, or it may have been cancelled meanwhile, in
+// which case it is left as it is.
+func (r *postgresRep) markNotificationQueued(ctx context.Context, tx pgx.Tx, event OutboxEvent) error {
+	if event.EventType != EventNotificationCreated {
+		return nil
+	}
+
+	var created NotificationCreatedEvent
+
+	if err := json.Unmarshal(event.Payload, &created); err != nil {
+		return fmt.Errorf("failed to unmarshal %s event %d: %w", event.EventType, event.ID, err)
+	}
+
+	// Events written before they were split carry no parts.
+	if created.Part < created.Parts {
+		return nil
+	}
+
+	err := r.transitionNotification(ctx, tx, created.NotificationID, created.CreatedAt, notificationStatusQueued)
+	if err != nil && !errors.Is(err, apperrors.InvalidTransitionError{}) {
+		return err
+	}
+
+	return nil
+}
+
+func (r *postgresRep) DeletePublishedOutboxEvents(ctx context.Context, olderThan time.Time) (int64, error) {
+	query := r.sb.
+		Delete("outbox_events").
+		Where(sq.NotEq{"published_at": nil}).
+		Where(sq.Lt{"published_at": olderThan})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := r.pool.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
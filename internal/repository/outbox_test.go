@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestNotificationCreatedEventSplit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		recipients int
+		wantSizes  []int
+	}{
+		{
+			name:      "no recipients",
+			wantSizes: []int{0},
+		},
+		{
+			name:       "single part",
+			recipients: 3,
+			wantSizes:  []int{3},
+		},
+		{
+			name:       "exactly full part",
+			recipients: maxDispatchRecipients,
+			wantSizes:  []int{maxDispatchRecipients},
+		},
+		{
+			name:       "several parts",
+			recipients: 2*maxDispatchRecipients + 1,
+			wantSizes:  []int{maxDispatchRecipients, maxDispatchRecipients, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			event := NotificationCreatedEvent{NotificationID: "id", Recipients: make([]DispatchRecipient, tt.recipients)}
+			for i := range event.Recipients {
+				event.Recipients[i].RecipientID = strconv.Itoa(i)
+			}
+
+			parts := event.split()
+
+			var (
+				sizes []int
+				seen  []DispatchRecipient
+			)
+
+			for i, part := range parts {
+				if part.NotificationID != event.NotificationID || part.Part != i+1 || part.Parts != len(parts) {
+					t.Errorf("part %d = %s %d/%d, want %s %d/%d",
+						i, part.NotificationID, part.Part, part.Parts, event.NotificationID, i+1, len(parts))
+				}
+
+				sizes = append(sizes, len(part.Recipients))
+				seen = append(seen, part.Recipients...)
+			}
+
+			if !slices.Equal(sizes, tt.wantSizes) {
+				t.Errorf("split() part sizes = %v, want %v", sizes, tt.wantSizes)
+			}
+
+			if !slices.Equal(seen, event.Recipients) {
+				t.Errorf("split() does not keep the recipients in order")
+			}
+		})
+	}
+}
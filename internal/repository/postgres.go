@@ -14,43 +14,115 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	"github.com/notification-system-moxicom/persistence-service/internal/partitions"
 	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+	"github.com/notification-system-moxicom/persistence-service/pkg/util/generic"
 )
 
+const defaultIdempotencyKeyTTL = 24 * time.Hour
+
 type Config struct {
-	UserNameENV string `yaml:"username_env"`
-	PassENV     string `yaml:"password_env"`
-	HostENV     string `yaml:"host_env"`
-	DSNWithEnv  string `yaml:"dsn_with_env"` // example: postgres://{{DB_USERNAME}}:{{DB_PASSWORD}}@{{DB_HOST}}:5432/database_name
+	UserNameENV       string        `yaml:"username_env"`
+	PassENV           string        `yaml:"password_env"`
+	HostENV           string        `yaml:"host_env"`
+	DSNWithEnv        string        `yaml:"dsn_with_env"` // example: postgres://{{DB_USERNAME}}:{{DB_PASSWORD}}@{{DB_HOST}}:5432/database_name
+	IdempotencyKeyTTL time.Duration `yaml:"idempotency_key_ttl"`
+
+	Partitions partitions.Config `yaml:"partitions"`
 }
 
 type SystemRepository interface {
-	CreateSystem(ctx context.Context, name, description string) (*rpcv1.System, error)
+	CreateSystem(
+		ctx context.Context,
+		name, description string,
+		dedupWindow time.Duration,
+		timezone string,
+		quietHours []*rpcv1.QuietWindow,
+	) (*rpcv1.System, error)
 	ListSystems(ctx context.Context) ([]*rpcv1.System, error)
-	UpdateSystem(ctx context.Context, id string, name, description *string) (*rpcv1.System, error)
+	UpdateSystem(
+		ctx context.Context,
+		id string,
+		name, description *string,
+		dedupWindow *time.Duration,
+		quietHours QuietHoursUpdate,
+	) (*rpcv1.System, error)
 	DeleteSystem(ctx context.Context, id string) error
 }
 
 type UserRepository interface {
-	AddUser(ctx context.Context, systemID, idAtSystem string, adapters *rpcv1.Adapter) (*rpcv1.User, error)
+	AddUser(
+		ctx context.Context,
+		systemID, idAtSystem string,
+		adapters *rpcv1.Adapter,
+		attributes map[string]string,
+		timezone string,
+		quietHours []*rpcv1.QuietWindow,
+	) (*rpcv1.User, error)
 	ListUsers(ctx context.Context, systemID string) ([]*rpcv1.User, error)
-	UpdateUser(ctx context.Context, id string, idAtSystem *string, adapters *rpcv1.Adapter) (*rpcv1.User, error)
+	UpdateUser(
+		ctx context.Context,
+		id string,
+		idAtSystem *string,
+		adapters *rpcv1.Adapter,
+		attributes map[string]string,
+		removedAttributes []string,
+		quietHours QuietHoursUpdate,
+	) (*rpcv1.User, error)
 	DeleteUser(ctx context.Context, id string) error
+	GetUserSystemID(ctx context.Context, id string) (string, error)
 }
 
 type NotificationRepository interface {
-	CreateNotification(ctx context.Context, systemID string, userIDs []string, content string) (string, error)
+	CreateNotification(ctx context.Context, params CreateNotificationParams) (*CreateNotificationResult, error)
+	CreateNotificationBatch(
+		ctx context.Context,
+		systemID string,
+		items []NotificationBatchItem,
+	) ([]NotificationBatchResult, error)
+	GetNotification(ctx context.Context, id string) (*rpcv1.Notification, error)
+	ListNotifications(ctx context.Context, filter NotificationFilter) ([]*rpcv1.Notification, string, error)
+	UpdateRecipientStatus(
+		ctx context.Context,
+		notificationID string,
+		notificationCreatedAt time.Time,
+		userID string,
+		channel rpcv1.Channel,
+		status rpcv1.DeliveryStatus,
+		lastError string,
+	) (*rpcv1.RecipientDelivery, error)
+	ListRecipientDeliveries(ctx context.Context, notificationID string) ([]*rpcv1.RecipientDelivery, error)
+	CancelNotification(ctx context.Context, id string) (*rpcv1.Notification, error)
+}
+
+type SchedulerRepository interface {
+	ReleaseScheduledNotifications(ctx context.Context, now time.Time, limit int) (int, error)
+	ExpireScheduledNotifications(ctx context.Context, dueBefore time.Time, limit int) (int, error)
+	DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error)
 }
 
 type Repository interface {
 	SystemRepository
 	UserRepository
 	NotificationRepository
+	OutboxRepository
+	SchedulerRepository
+	TemplateRepository
+	GroupRepository
+	EventRepository
+	RateLimitRepository
+	QuotaRepository
+	PreferenceRepository
+	CategoryRepository
+	DigestRepository
 }
 
 type postgresRep struct {
 	pool *pgxpool.Pool
 	sb   sq.StatementBuilderType
+
+	idempotencyKeyTTL time.Duration
 }
 
 // New constructs a new Postgres-backed repository.
@@ -71,8 +143,9 @@ func New(ctx context.Context, cfg Config) (Repository, error) {
 	}
 
 	return &postgresRep{
-		pool: pool,
-		sb:   sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+		pool:              pool,
+		sb:                sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+		idempotencyKeyTTL: generic.DefaultIfZero(cfg.IdempotencyKeyTTL, defaultIdempotencyKeyTTL),
 	}, nil
 }
 
@@ -104,51 +177,37 @@ func (r *postgresRep) CreateSystem(
 	ctx context.Context,
 	name,
 	description string,
+	dedupWindow time.Duration,
+	timezone string,
+	quietHours []*rpcv1.QuietWindow,
 ) (*rpcv1.System, error) {
 	now := time.Now().UTC()
 
-	query := r.sb.
-		Insert("systems").
-		Columns("name", "description", "created_at", "updated_at").
-		Values(name, description, now, now).
-		Suffix("RETURNING id, name, description, created_at, updated_at, deleted_at")
-
-	sqlStr, args, err := query.ToSql()
+	quietHoursDoc, err := marshalQuietHours(quietHours)
 	if err != nil {
 		return nil, err
 	}
 
-	row := r.pool.QueryRow(ctx, sqlStr, args...)
-
-	var (
-		id        string
-		createdAt time.Time
-		updatedAt time.Time
-		deletedAt sql.NullTime
-	)
+	query := r.sb.
+		Insert("systems").
+		Columns(
+			"name", "description", "dedup_window_seconds", "default_timezone", "default_quiet_hours",
+			"created_at", "updated_at",
+		).
+		Values(name, description, int64(dedupWindow/time.Second), nullString(timezone), quietHoursDoc, now, now).
+		Suffix("RETURNING " + systemColumns)
 
-	if err := row.Scan(&id, &name, &description, &createdAt, &updatedAt, &deletedAt); err != nil {
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
 		return nil, err
 	}
 
-	system := &rpcv1.System{
-		Id:          id,
-		Name:        name,
-		Description: description,
-		CreatedAt:   createdAt.Unix(),
-		UpdatedAt:   updatedAt.Unix(),
-	}
-
-	if deletedAt.Valid {
-		system.DeletedAt = deletedAt.Time.Unix()
-	}
-
-	return system, nil
+	return scanSystem(r.pool.QueryRow(ctx, sqlStr, args...))
 }
 
 func (r *postgresRep) ListSystems(ctx context.Context) ([]*rpcv1.System, error) {
 	query := r.sb.
-		Select("id", "name", "description", "created_at", "updated_at", "deleted_at").
+		Select(systemColumns).
 		From("systems")
 
 	sqlStr, args, err := query.ToSql()
@@ -165,31 +224,11 @@ func (r *postgresRep) ListSystems(ctx context.Context) ([]*rpcv1.System, error)
 	var systems []*rpcv1.System
 
 	for rows.Next() {
-		var (
-			id          string
-			name        string
-			description string
-			createdAt   time.Time
-			updatedAt   time.Time
-			deletedAt   sql.NullTime
-		)
-
-		if err := rows.Scan(&id, &name, &description, &createdAt, &updatedAt, &deletedAt); err != nil {
+		system, err := scanSystem(rows)
+		if err != nil {
 			return nil, err
 		}
 
-		system := &rpcv1.System{
-			Id:          id,
-			Name:        name,
-			Description: description,
-			CreatedAt:   createdAt.Unix(),
-			UpdatedAt:   updatedAt.Unix(),
-		}
-
-		if deletedAt.Valid {
-			system.DeletedAt = deletedAt.Time.Unix()
-		}
-
 		systems = append(systems, system)
 	}
 
@@ -205,47 +244,40 @@ func (r *postgresRep) AddUser(
 	systemID,
 	idAtSystem string,
 	adapters *rpcv1.Adapter,
+	attributes map[string]string,
+	timezone string,
+	quietHours []*rpcv1.QuietWindow,
 ) (*rpcv1.User, error) {
-	query := r.sb.
-		Insert("users").
-		Columns("system_id", "id_at_system", "email", "phone", "telegram_chat_id").
-		Values(systemID, idAtSystem, adapters.GetEmail(), adapters.GetPhone(), adapters.GetTelegramChatId()).
-		Suffix("RETURNING id, system_id, id_at_system, email, phone, telegram_chat_id")
-
-	sqlStr, args, err := query.ToSql()
+	attributesDoc, err := marshalAttributes(attributes)
 	if err != nil {
 		return nil, err
 	}
 
-	row := r.pool.QueryRow(ctx, sqlStr, args...)
+	quietHoursDoc, err := marshalQuietHours(quietHours)
+	if err != nil {
+		return nil, err
+	}
 
-	var (
-		id            string
-		outSystemID   string
-		outIDAtSystem string
-		email         string
-		phone         string
-		telegramID    string
-	)
+	query := r.sb.
+		Insert("users").
+		Columns("system_id", "id_at_system", "email", "phone", "telegram_chat_id", "attributes", "timezone", "quiet_hours").
+		Values(
+			systemID, idAtSystem, adapters.GetEmail(), adapters.GetPhone(), adapters.GetTelegramChatId(), attributesDoc,
+			nullString(timezone), quietHoursDoc,
+		).
+		Suffix("RETURNING " + userColumns)
 
-	if err := row.Scan(&id, &outSystemID, &outIDAtSystem, &email, &phone, &telegramID); err != nil {
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
 		return nil, err
 	}
 
-	return &rpcv1.User{
-		Id:         id,
-		IdAtSystem: outIDAtSystem,
-		Adapters: &rpcv1.Adapter{
-			Email:          email,
-			Phone:          phone,
-			TelegramChatId: telegramID,
-		},
-	}, nil
+	return scanUser(r.pool.QueryRow(ctx, sqlStr, args...))
 }
 
 func (r *postgresRep) ListUsers(ctx context.Context, systemID string) ([]*rpcv1.User, error) {
 	query := r.sb.
-		Select("id", "system_id", "id_at_system", "email", "phone", "telegram_chat_id").
+		Select(userColumns).
 		From("users").
 		Where(sq.Eq{"system_id": systemID})
 
@@ -263,28 +295,12 @@ func (r *postgresRep) ListUsers(ctx context.Context, systemID string) ([]*rpcv1.
 	var users []*rpcv1.User
 
 	for rows.Next() {
-		var (
-			id            string
-			outSystemID   string
-			outIDAtSystem string
-			email         string
-			phone         string
-			telegramID    string
-		)
-
-		if err := rows.Scan(&id, &outSystemID, &outIDAtSystem, &email, &phone, &telegramID); err != nil {
+		user, err := scanUser(rows)
+		if err != nil {
 			return nil, err
 		}
 
-		users = append(users, &rpcv1.User{
-			Id:         id,
-			IdAtSystem: outIDAtSystem,
-			Adapters: &rpcv1.Adapter{
-				Email:          email,
-				Phone:          phone,
-				TelegramChatId: telegramID,
-			},
-		})
+		users = append(users, user)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -298,6 +314,8 @@ func (r *postgresRep) UpdateSystem(
 	ctx context.Context,
 	id string,
 	name, description *string,
+	dedupWindow *time.Duration,
+	quietHours QuietHoursUpdate,
 ) (*rpcv1.System, error) {
 	now := time.Now().UTC()
 	query := r.sb.Update("systems").Set("updated_at", now)
@@ -310,42 +328,63 @@ func (r *postgresRep) UpdateSystem(
 		query = query.Set("description", *description)
 	}
 
+	if dedupWindow != nil {
+		query = query.Set("dedup_window_seconds", int64(*dedupWindow/time.Second))
+	}
+
+	query, err := quietHours.apply(query, "default_timezone", "default_quiet_hours")
+	if err != nil {
+		return nil, err
+	}
+
 	query = query.Where(sq.Eq{"id": id}).
-		Suffix("RETURNING id, name, description, created_at, updated_at, deleted_at")
+		Suffix("RETURNING " + systemColumns)
 
 	sqlStr, args, err := query.ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	row := r.pool.QueryRow(ctx, sqlStr, args...)
+	return scanSystem(r.pool.QueryRow(ctx, sqlStr, args...))
+}
+
+const systemColumns = "id, name, description, dedup_window_seconds, default_timezone, default_quiet_hours, " +
+	"created_at, updated_at, deleted_at"
 
+func scanSystem(row pgx.Row) (*rpcv1.System, error) {
 	var (
-		outID     string
-		outName   string
-		outDesc   string
-		createdAt time.Time
-		updatedAt time.Time
-		deletedAt sql.NullTime
+		system      rpcv1.System
+		description sql.NullString
+		createdAt   time.Time
+		updatedAt   time.Time
+		deletedAt   sql.NullTime
+		timezone    sql.NullString
+		quietHours  []byte
 	)
 
-	if err := row.Scan(&outID, &outName, &outDesc, &createdAt, &updatedAt, &deletedAt); err != nil {
+	if err := row.Scan(
+		&system.Id, &system.Name, &description, &system.DedupWindowSeconds, &timezone, &quietHours,
+		&createdAt, &updatedAt, &deletedAt,
+	); err != nil {
 		return nil, err
 	}
 
-	system := &rpcv1.System{
-		Id:          outID,
-		Name:        outName,
-		Description: outDesc,
-		CreatedAt:   createdAt.Unix(),
-		UpdatedAt:   updatedAt.Unix(),
+	defaultQuietHours, err := unmarshalQuietHours(quietHours)
+	if err != nil {
+		return nil, err
 	}
 
+	system.Description = description.String
+	system.DefaultTimezone = timezone.String
+	system.DefaultQuietHours = defaultQuietHours
+	system.CreatedAt = createdAt.Unix()
+	system.UpdatedAt = updatedAt.Unix()
+
 	if deletedAt.Valid {
 		system.DeletedAt = deletedAt.Time.Unix()
 	}
 
-	return system, nil
+	return &system, nil
 }
 
 func (r *postgresRep) DeleteSystem(ctx context.Context, id string) error {
@@ -370,6 +409,9 @@ func (r *postgresRep) UpdateUser(
 	id string,
 	idAtSystem *string,
 	adapters *rpcv1.Adapter,
+	attributes map[string]string,
+	removedAttributes []string,
+	quietHours QuietHoursUpdate,
 ) (*rpcv1.User, error) {
 	query := r.sb.Update("users")
 
@@ -383,38 +425,77 @@ func (r *postgresRep) UpdateUser(
 		query = query.Set("telegram_chat_id", adapters.GetTelegramChatId())
 	}
 
+	// Set attributes are merged into the stored ones, removed keys are dropped afterwards
+	if len(attributes) > 0 || len(removedAttributes) > 0 {
+		attributesDoc, err := marshalAttributes(attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		if removedAttributes == nil {
+			removedAttributes = []string{} // jsonb - NULL would wipe the column
+		}
+
+		query = query.Set("attributes", sq.Expr("(attributes || ?::jsonb) - ?::text[]", attributesDoc, removedAttributes))
+	}
+
+	query, err := quietHours.apply(query, "timezone", "quiet_hours")
+	if err != nil {
+		return nil, err
+	}
+
 	query = query.Where(sq.Eq{"id": id}).
-		Suffix("RETURNING id, system_id, id_at_system, email, phone, telegram_chat_id")
+		Suffix("RETURNING " + userColumns)
 
 	sqlStr, args, err := query.ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	row := r.pool.QueryRow(ctx, sqlStr, args...)
+	return scanUser(r.pool.QueryRow(ctx, sqlStr, args...))
+}
+
+const userColumns = "id, system_id, id_at_system, email, phone, telegram_chat_id, attributes, timezone, quiet_hours"
 
+func scanUser(row pgx.Row) (*rpcv1.User, error) {
 	var (
-		outID         string
-		outSystemID   string
-		outIDAtSystem string
+		user          rpcv1.User
+		systemID      string
 		email         string
 		phone         string
 		telegramID    string
+		rawAttributes []byte
+		timezone      sql.NullString
+		rawQuietHours []byte
 	)
 
-	if err := row.Scan(&outID, &outSystemID, &outIDAtSystem, &email, &phone, &telegramID); err != nil {
+	if err := row.Scan(
+		&user.Id, &systemID, &user.IdAtSystem, &email, &phone, &telegramID, &rawAttributes,
+		&timezone, &rawQuietHours,
+	); err != nil {
 		return nil, err
 	}
 
-	return &rpcv1.User{
-		Id:         outID,
-		IdAtSystem: outIDAtSystem,
-		Adapters: &rpcv1.Adapter{
-			Email:          email,
-			Phone:          phone,
-			TelegramChatId: telegramID,
-		},
-	}, nil
+	attributes, err := unmarshalAttributes(rawAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	quietHours, err := unmarshalQuietHours(rawQuietHours)
+	if err != nil {
+		return nil, err
+	}
+
+	user.Adapters = &rpcv1.Adapter{
+		Email:          email,
+		Phone:          phone,
+		TelegramChatId: telegramID,
+	}
+	user.Attributes = attributes
+	user.Timezone = timezone.String
+	user.QuietHours = quietHours
+
+	return &user, nil
 }
 
 func (r *postgresRep) DeleteUser(ctx context.Context, id string) error {
@@ -432,15 +513,108 @@ func (r *postgresRep) DeleteUser(ctx context.Context, id string) error {
 	return err
 }
 
+// GetUserSystemID returns the system a user belongs to, or an empty string
+// when there is no such user.
+func (r *postgresRep) GetUserSystemID(ctx context.Context, id string) (string, error) {
+	query := r.sb.
+		Select("system_id").
+		From("users").
+		Where(sq.Eq{"id": id})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	var systemID string
+
+	if err = r.pool.QueryRow(ctx, sqlStr, args...).Scan(&systemID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return systemID, nil
+}
+
+// CreateNotificationParams describes a notification to store.
+type CreateNotificationParams struct {
+	SystemID string
+	UserIDs  []string // ids at system
+	Content  string   // plain text, the fallback for channels without a variant
+
+	// GroupIDs adds the members of these groups to UserIDs.
+	GroupIDs []string
+
+	// AllUsers addresses every user of the system instead of UserIDs and GroupIDs.
+	// AttributeFilter optionally narrows it down to the matching users.
+	AllUsers        bool
+	AttributeFilter *rpcv1.AttributeFilter
+
+	// StructuredContent optionally carries per-channel variants of Content.
+	StructuredContent *rpcv1.NotificationContent
+
+	// SendAt holds the notification back until the given time. Zero or past
+	// values dispatch it right away.
+	SendAt time.Time
+
+	// IdempotencyKey is optional. RequestHash identifies the request payload
+	// and is compared when the key has been used before.
+	IdempotencyKey string
+	RequestHash    string
+
+	// StrictRecipients rejects the notification if any of UserIDs is unknown.
+	// Otherwise unknown ids are skipped and reported back.
+	StrictRecipients bool
+
+	// DedupWindow overrides the deduplication window of the system when
+	// positive. DedupKey tells identical notifications apart, the content is
+	// compared when it is empty.
+	DedupWindow time.Duration
+	DedupKey    string
+
+	// Urgent notifications reach recipients during their quiet hours too.
+	Urgent bool
+
+	// Category is optional, recipients who muted it are suppressed.
+	Category string
+
+	// LowPriority notifications reach recipients with a digest mode in their
+	// next digest.
+	LowPriority bool
+}
+
+// CreateNotificationResult reports what CreateNotification has stored.
+type CreateNotificationResult struct {
+	NotificationID string
+	Resolved       []string // requested ids at system that became recipients
+	Unresolved     []string // requested ids at system unknown to the system
+	RecipientCount int64
+
+	// DeduplicatedCount is the number of recipients, out of RecipientCount,
+	// that were suppressed as duplicates.
+	DeduplicatedCount int64
+
+	// SuppressedCount is the number of recipients, out of RecipientCount,
+	// whose preferences ruled the notification out.
+	SuppressedCount int64
+
+	// DigestedCount is the number of recipients, out of RecipientCount, that
+	// get the notification in their next digest.
+	DigestedCount int64
+}
+
 func (r *postgresRep) CreateNotification(
 	ctx context.Context,
-	systemID string,
-	userIDs []string,
-	content string,
-) (string, error) {
+	params CreateNotificationParams,
+) (*CreateNotificationResult, error) {
+	systemID := params.SystemID
+
 	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
-		return "", fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
 	defer func() {
@@ -449,86 +623,186 @@ func (r *postgresRep) CreateNotification(
 		}
 	}()
 
-	// Resolve user UUIDs by id_at_system within the given system
-	resolveQuery := r.sb.
-		Select("id").
-		From("users").
-		Where(sq.Eq{
-			"system_id":    systemID,
-			"id_at_system": userIDs,
-		})
+	if params.IdempotencyKey != "" {
+		var (
+			existingID string
+			claimed    bool
+		)
 
-	resolveSql, resolveArgs, err := resolveQuery.ToSql()
-	if err != nil {
-		return "", fmt.Errorf("failed to build resolve query: %w", err)
-	}
+		existingID, claimed, err = r.claimIdempotencyKey(ctx, tx, params)
+		if err != nil {
+			return nil, err
+		}
 
-	rows, err := tx.Query(ctx, resolveSql, resolveArgs...)
-	if err != nil {
-		return "", fmt.Errorf("failed to resolve users: %w", err)
+		if !claimed {
+			_ = tx.Rollback(ctx)
+
+			return r.replayNotificationResult(ctx, existingID, params.UserIDs)
+		}
 	}
 
+	result := &CreateNotificationResult{}
+
 	var resolvedUserUUIDs []string
 
-	for rows.Next() {
-		var uid string
-		if err = rows.Scan(&uid); err != nil {
-			rows.Close()
-			return "", fmt.Errorf("failed to scan user id: %w", err)
+	// Broadcasts are resolved by the database while inserting recipients,
+	// explicit recipients are resolved up front to report unknown ids.
+	if !params.AllUsers && len(params.UserIDs) > 0 {
+		var found map[string]struct{}
+
+		resolvedUserUUIDs, found, err = r.resolveUsers(ctx, tx, systemID, params.UserIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Resolved, result.Unresolved = splitResolved(params.UserIDs, found)
+
+		if (params.StrictRecipients && len(result.Unresolved) > 0) ||
+			(len(resolvedUserUUIDs) == 0 && len(params.GroupIDs) == 0) {
+			err = unresolvedRecipientsError(systemID, result.Unresolved)
+
+			return nil, err
 		}
+	}
 
-		resolvedUserUUIDs = append(resolvedUserUUIDs, uid)
+	if !params.AllUsers && len(params.GroupIDs) > 0 {
+		if err = r.checkGroups(ctx, tx, systemID, params.GroupIDs); err != nil {
+			return nil, err
+		}
 	}
 
-	rows.Close()
+	key := newNotificationKey(time.Now())
+	notificationID, now := key.ID, key.CreatedAt
+	notifStatus := notificationStatusPending
 
-	if err = rows.Err(); err != nil {
-		return "", fmt.Errorf("failed to iterate user rows: %w", err)
+	var sendAt sql.NullTime
+
+	if params.SendAt.After(now) {
+		notifStatus = notificationStatusScheduled
+		sendAt = sql.NullTime{Time: params.SendAt.UTC(), Valid: true}
+	}
+
+	variants, err := marshalContentVariants(params.StructuredContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal content variants: %w", err)
 	}
 
-	if len(resolvedUserUUIDs) == 0 {
-		return "", fmt.Errorf("no users found for system %s with given ids", systemID)
+	dedupKey := params.DedupKey
+	if dedupKey == "" {
+		dedupKey = contentDedupKey(params.Content, variants)
 	}
 
-	// Create one notification with status pending
+	// Create one notification, pending or scheduled for later
 	insertNotifQuery := r.sb.
 		Insert("notifications").
-		Columns("system_id", "content", "status", "created_at").
-		Values(systemID, content, "pending", time.Now().UTC()).
-		Suffix("RETURNING id")
+		Columns(
+			"id", "system_id", "content", "content_variants", "status", "created_at", "send_at", "dedup_key", "category",
+		).
+		Values(
+			notificationID, systemID, params.Content, variants, notifStatus, now, sendAt, dedupKey,
+			nullString(params.Category),
+		)
 
 	insertNotifSql, insertNotifArgs, err := insertNotifQuery.ToSql()
 	if err != nil {
-		return "", fmt.Errorf("failed to build insert notification query: %w", err)
+		return nil, fmt.Errorf("failed to build insert notification query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, insertNotifSql, insertNotifArgs...); err != nil {
+		return nil, fmt.Errorf("failed to insert notification: %w", err)
+	}
+
+	if params.AllUsers {
+		result.RecipientCount, err = r.insertSystemRecipients(ctx, tx, notificationID, now, systemID, params.AttributeFilter)
+	} else {
+		result.RecipientCount, err = r.insertRecipients(ctx, tx, notificationID, now, systemID, resolvedUserUUIDs, params.GroupIDs)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.RecipientCount == 0 {
+		err = apperrors.NewNotFoundError("recipients not found", "no users of system "+systemID+" match the audience")
+
+		return nil, err
+	}
+
+	result.DeduplicatedCount, err = r.deduplicateRecipients(
+		ctx, tx, notificationID, now, systemID, dedupKey, params.DedupWindow,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := r.loadCategoryPolicy(ctx, tx, systemID, params.Category)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load category: %w", err)
+	}
+
+	result.SuppressedCount, err = r.suppressRecipients(ctx, tx, notificationID, now, category)
+	if err != nil {
+		return nil, err
+	}
+
+	deliverAt := now
+	if sendAt.Valid {
+		deliverAt = sendAt.Time
+	}
+
+	if params.LowPriority {
+		result.DigestedCount, err = r.digestRecipients(ctx, tx, notificationID, now, deliverAt, category, params.Content)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	var notificationID string
+	// Every recipient already got this notification, does not want it or gets
+	// it in a digest, there is nothing left to deliver.
+	if result.DeduplicatedCount+result.SuppressedCount+result.DigestedCount == result.RecipientCount {
+		notifStatus = notificationStatusSent
 
-	if err = tx.QueryRow(ctx, insertNotifSql, insertNotifArgs...).Scan(&notificationID); err != nil {
-		return "", fmt.Errorf("failed to insert notification: %w", err)
+		if err = r.transitionNotification(ctx, tx, notificationID, now, notifStatus); err != nil {
+			return nil, err
+		}
 	}
 
-	// Insert recipients for this notification
-	recipientsQuery := r.sb.
-		Insert("notification_recipients").
-		Columns("notification_id", "user_id")
+	// Recipients in their quiet hours when the notification is handed over are
+	// deferred until the quiet hours end.
+	if !params.Urgent && notifStatus != notificationStatusSent {
+		if err = r.deferQuietRecipients(ctx, tx, notificationID, now, deliverAt); err != nil {
+			return nil, err
+		}
+	}
 
-	for _, uid := range resolvedUserUUIDs {
-		recipientsQuery = recipientsQuery.Values(notificationID, uid)
+	// Hand the notification to the orchestrator through the outbox, so it is
+	// published if and only if the notification is stored. Scheduled ones are
+	// handed over by the scheduler once they are due.
+	if notifStatus == notificationStatusPending {
+		if err = r.enqueueDispatch(ctx, tx, notificationID, now); err != nil {
+			return nil, err
+		}
 	}
 
-	recipientsSql, recipientsArgs, err := recipientsQuery.ToSql()
+	// Counted last, the usage rows of limited periods stay locked until commit.
+	usage, err := r.consumeQuota(ctx, tx, systemID, now, 1)
 	if err != nil {
-		return "", fmt.Errorf("failed to build insert recipients query: %w", err)
+		return nil, err
 	}
 
-	if _, err = tx.Exec(ctx, recipientsSql, recipientsArgs...); err != nil {
-		return "", fmt.Errorf("failed to insert notification recipients: %w", err)
+	if params.IdempotencyKey != "" {
+		if err = r.bindIdempotencyKey(ctx, tx, systemID, params.IdempotencyKey, notificationID); err != nil {
+			return nil, err
+		}
 	}
 
 	if err = tx.Commit(ctx); err != nil {
-		return "", fmt.Errorf("failed to commit transaction: %w", err)
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return notificationID, nil
+	r.recordUsage(ctx, usage)
+
+	result.NotificationID = notificationID
+
+	return result, nil
 }
@@ -0,0 +1,397 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+// StatusReasonSuppressedByPreference marks recipients whose preferences rule
+// the notification out.
+const StatusReasonSuppressedByPreference = "suppressed_by_preference"
+
+const preferenceColumns = "user_id, disabled_channels, muted_categories, subscribed_categories, digest_mode, updated_at"
+
+type PreferenceRepository interface {
+	GetPreferences(ctx context.Context, userID string) (*rpcv1.Preferences, error)
+	SetPreferences(ctx context.Context, preferences *rpcv1.Preferences) (*rpcv1.Preferences, error)
+	SubscribeCategory(ctx context.Context, userID, category string) (*rpcv1.Preferences, error)
+	UnsubscribeCategory(ctx context.Context, userID, category string) (*rpcv1.Preferences, error)
+	Unsubscribe(ctx context.Context, systemID, userID string, channel rpcv1.Channel, category string) error
+}
+
+// recipientPreferences are the stored preferences of a recipient, empty for
+// users that have never set any.
+type recipientPreferences struct {
+	disabledChannels     []string
+	mutedCategories      []string
+	subscribedCategories []string
+	digestMode           sql.NullString
+}
+
+// receives reports whether the user wants notifications of the category.
+func (p recipientPreferences) receives(category categoryPolicy) bool {
+	switch {
+	case category.name == "" || category.mandatory:
+		return true
+	case slices.Contains(p.mutedCategories, category.name):
+		return false
+	case slices.Contains(p.subscribedCategories, category.name):
+		return true
+	default:
+		return category.defaultOptIn
+	}
+}
+
+// apply removes the addresses of disabled channels from the recipient. It
+// reports whether the notification must be suppressed, because the user does
+// not receive its category or disabled every channel the user has an address
+// for. Mandatory categories only override the category subscription.
+func (p recipientPreferences) apply(recipient *DispatchRecipient, category categoryPolicy) bool {
+	if !p.receives(category) {
+		return true
+	}
+
+	hadAddress := recipient.Email != "" || recipient.Phone != "" || recipient.TelegramChatID != ""
+
+	if slices.Contains(p.disabledChannels, channelEmail) {
+		recipient.Email = ""
+	}
+
+	if slices.Contains(p.disabledChannels, channelSMS) {
+		recipient.Phone = ""
+	}
+
+	if slices.Contains(p.disabledChannels, channelTelegram) {
+		recipient.TelegramChatID = ""
+	}
+
+	return hadAddress && recipient.Email == "" && recipient.Phone == "" && recipient.TelegramChatID == ""
+}
+
+// preferenceChange adds entries to the preference lists of a user. A category
+// added to the muted or the subscribed categories is removed from the other.
+type preferenceChange struct {
+	disabledChannels     []string
+	mutedCategories      []string
+	subscribedCategories []string
+}
+
+// GetPreferences returns the preferences of a user, empty ones when the user
+// has never set any.
+func (r *postgresRep) GetPreferences(ctx context.Context, userID string) (*rpcv1.Preferences, error) {
+	query := r.sb.
+		Select(
+			"u.id", "p.disabled_channels", "p.muted_categories", "p.subscribed_categories", "p.digest_mode", "p.updated_at",
+		).
+		From("users u").
+		LeftJoin("user_preferences p ON p.user_id = u.id").
+		Where(sq.Eq{"u.id": userID})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := scanPreferences(r.pool.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, userNotFound(userID)
+		}
+
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+// SetPreferences replaces the preferences of a user.
+func (r *postgresRep) SetPreferences(ctx context.Context, preferences *rpcv1.Preferences) (*rpcv1.Preferences, error) {
+	now := time.Now().UTC()
+
+	disabledChannels := make([]string, 0, len(preferences.GetDisabledChannels()))
+	for _, channel := range preferences.GetDisabledChannels() {
+		disabledChannels = append(disabledChannels, channelToDB(channel))
+	}
+
+	mutedCategories := preferences.GetMutedCategories()
+	if mutedCategories == nil {
+		mutedCategories = []string{}
+	}
+
+	subscribedCategories := preferences.GetSubscribedCategories()
+	if subscribedCategories == nil {
+		subscribedCategories = []string{}
+	}
+
+	query := r.sb.
+		Insert("user_preferences").
+		Columns(
+			"user_id", "disabled_channels", "muted_categories", "subscribed_categories", "digest_mode", "created_at", "updated_at",
+		).
+		Values(
+			preferences.GetUserId(),
+			disabledChannels,
+			mutedCategories,
+			subscribedCategories,
+			digestModeToDB(preferences.GetDigestMode()),
+			now,
+			now,
+		).
+		Suffix(`ON CONFLICT (user_id) DO UPDATE SET
+			disabled_channels = EXCLUDED.disabled_channels,
+			muted_categories = EXCLUDED.muted_categories,
+			subscribed_categories = EXCLUDED.subscribed_categories,
+			digest_mode = EXCLUDED.digest_mode,
+			updated_at = EXCLUDED.updated_at
+		RETURNING ` + preferenceColumns)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := scanPreferences(r.pool.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, userNotFound(preferences.GetUserId())
+		}
+
+		return nil, err
+	}
+
+	return stored, nil
+}
+
+// SubscribeCategory makes the user receive the category.
+func (r *postgresRep) SubscribeCategory(ctx context.Context, userID, category string) (*rpcv1.Preferences, error) {
+	return r.changePreferences(ctx, userID, "", preferenceChange{subscribedCategories: []string{category}})
+}
+
+// UnsubscribeCategory stops the user from receiving the category, unless it is
+// mandatory.
+func (r *postgresRep) UnsubscribeCategory(ctx context.Context, userID, category string) (*rpcv1.Preferences, error) {
+	return r.changePreferences(ctx, userID, "", preferenceChange{mutedCategories: []string{category}})
+}
+
+// Unsubscribe unsubscribes the user from the category, or disables the channel
+// when category is empty. The user must belong to the system.
+func (r *postgresRep) Unsubscribe(ctx context.Context, systemID, userID string, channel rpcv1.Channel, category string) error {
+	var change preferenceChange
+
+	if category != "" {
+		change.mutedCategories = []string{category}
+	} else {
+		change.disabledChannels = []string{channelToDB(channel)}
+	}
+
+	if _, err := r.changePreferences(ctx, userID, systemID, change); err != nil {
+		return fmt.Errorf("failed to unsubscribe user: %w", err)
+	}
+
+	return nil
+}
+
+// changePreferences applies the change to the preferences of the user and
+// returns them. A non-empty systemID restricts the change to users of that
+// system.
+func (r *postgresRep) changePreferences(
+	ctx context.Context,
+	userID, systemID string,
+	change preferenceChange,
+) (*rpcv1.Preferences, error) {
+	now := time.Now().UTC()
+
+	user := r.sb.
+		Select("id").
+		Column("?::text[]", append([]string{}, change.disabledChannels...)).
+		Column("?::text[]", append([]string{}, change.mutedCategories...)).
+		Column("?::text[]", append([]string{}, change.subscribedCategories...)).
+		Column("?", now).
+		Column("?", now).
+		From("users").
+		Where(sq.Eq{"id": userID})
+
+	if systemID != "" {
+		user = user.Where(sq.Eq{"system_id": systemID})
+	}
+
+	query := r.sb.
+		Insert("user_preferences").
+		Columns("user_id", "disabled_channels", "muted_categories", "subscribed_categories", "created_at", "updated_at").
+		Select(user).
+		Suffix(`ON CONFLICT (user_id) DO UPDATE SET
+			disabled_channels = ARRAY(
+				SELECT DISTINCT unnest(user_preferences.disabled_channels || EXCLUDED.disabled_channels)
+			),
+			muted_categories = ARRAY(
+				SELECT DISTINCT c FROM unnest(user_preferences.muted_categories || EXCLUDED.muted_categories) c
+				WHERE c <> ALL(EXCLUDED.subscribed_categories)
+			),
+			subscribed_categories = ARRAY(
+				SELECT DISTINCT c FROM unnest(user_preferences.subscribed_categories || EXCLUDED.subscribed_categories) c
+				WHERE c <> ALL(EXCLUDED.muted_categories)
+			),
+			updated_at = EXCLUDED.updated_at
+		RETURNING ` + preferenceColumns)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := scanPreferences(r.pool.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, userNotFound(userID)
+		}
+
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+func scanPreferences(row pgx.Row) (*rpcv1.Preferences, error) {
+	var (
+		preferences      rpcv1.Preferences
+		disabledChannels []string
+		digestMode       sql.NullString
+		updatedAt        sql.NullTime
+	)
+
+	if err := row.Scan(
+		&preferences.UserId,
+		&disabledChannels,
+		&preferences.MutedCategories,
+		&preferences.SubscribedCategories,
+		&digestMode,
+		&updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	for _, channel := range disabledChannels {
+		preferences.DisabledChannels = append(preferences.DisabledChannels, channelFromDB(channel))
+	}
+
+	preferences.DigestMode = digestModeFromDB(digestMode)
+
+	if updatedAt.Valid {
+		preferences.UpdatedAt = updatedAt.Time.Unix()
+	}
+
+	return &preferences, nil
+}
+
+// suppressRecipients marks the queued recipients of the notification whose
+// preferences rule it out as suppressed, and returns how many were marked.
+func (r *postgresRep) suppressRecipients(
+	ctx context.Context,
+	tx pgx.Tx,
+	notificationID string,
+	createdAt time.Time,
+	category categoryPolicy,
+) (int64, error) {
+	query := r.sb.
+		Select(
+			"r.id", "u.email", "u.phone", "u.telegram_chat_id",
+			"p.disabled_channels", "p.muted_categories", "p.subscribed_categories",
+		).
+		From("notification_recipients r").
+		Join("users u ON u.id = r.user_id").
+		Where(sq.Eq{
+			"r.notification_id": notificationID,
+			"r.created_at":      createdAt,
+			"r.status":          deliveryStatusQueued,
+		})
+
+	// Users without preferences only miss categories that are opt-out by
+	// default.
+	if (recipientPreferences{}).receives(category) {
+		query = query.Join("user_preferences p ON p.user_id = r.user_id")
+	} else {
+		query = query.LeftJoin("user_preferences p ON p.user_id = r.user_id")
+	}
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build recipient preferences query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load recipient preferences: %w", err)
+	}
+
+	var suppressedIDs []string
+
+	for rows.Next() {
+		var (
+			recipientID    string
+			recipient      DispatchRecipient
+			email          sql.NullString
+			phone          sql.NullString
+			telegramChatID sql.NullString
+			preferences    recipientPreferences
+		)
+
+		if err = rows.Scan(
+			&recipientID, &email, &phone, &telegramChatID,
+			&preferences.disabledChannels, &preferences.mutedCategories, &preferences.subscribedCategories,
+		); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan recipient preferences: %w", err)
+		}
+
+		recipient.Email = email.String
+		recipient.Phone = phone.String
+		recipient.TelegramChatID = telegramChatID.String
+
+		if preferences.apply(&recipient, category) {
+			suppressedIDs = append(suppressedIDs, recipientID)
+		}
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to iterate recipient preferences: %w", err)
+	}
+
+	if len(suppressedIDs) == 0 {
+		return 0, nil
+	}
+
+	updateQuery := r.sb.
+		Update("notification_recipients").
+		Set("status", deliveryStatusSuppressed).
+		Set("status_reason", StatusReasonSuppressedByPreference).
+		Where(sq.Eq{"notification_id": notificationID, "created_at": createdAt}).
+		Where("id = ANY(?::uuid[])", suppressedIDs)
+
+	updateSql, updateArgs, err := updateQuery.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build suppress recipients query: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, updateSql, updateArgs...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to suppress recipients: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func userNotFound(id string) error {
+	return apperrors.NewNotFoundError("user not found", "user "+id+" does not exist")
+}
@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/notification-system-moxicom/persistence-service/internal/quiethours"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+// QuietHoursUpdate changes the timezone and quiet hours of a user, or the
+// defaults of a system. A nil Timezone keeps the current one, an empty one
+// clears it. QuietHours replace the current windows when not empty.
+type QuietHoursUpdate struct {
+	Timezone        *string
+	QuietHours      []*rpcv1.QuietWindow
+	ClearQuietHours bool
+}
+
+// effectiveTimezone and effectiveQuietHours resolve the settings of user u,
+// falling back to the defaults of system s.
+const (
+	effectiveTimezone   = "COALESCE(u.timezone, s.default_timezone, '')"
+	effectiveQuietHours = "COALESCE(u.quiet_hours, s.default_quiet_hours)"
+)
+
+func (u QuietHoursUpdate) apply(query sq.UpdateBuilder, timezoneColumn, quietHoursColumn string) (sq.UpdateBuilder, error) {
+	if u.Timezone != nil {
+		query = query.Set(timezoneColumn, nullString(*u.Timezone))
+	}
+
+	switch {
+	case len(u.QuietHours) > 0:
+		doc, err := marshalQuietHours(u.QuietHours)
+		if err != nil {
+			return query, err
+		}
+
+		query = query.Set(quietHoursColumn, doc)
+	case u.ClearQuietHours:
+		query = query.Set(quietHoursColumn, nil)
+	}
+
+	return query, nil
+}
+
+// marshalQuietHours encodes the windows for a JSONB column, nil when there are
+// none so the column falls back to the defaults.
+func marshalQuietHours(windows []*rpcv1.QuietWindow) ([]byte, error) {
+	if len(windows) == 0 {
+		return nil, nil
+	}
+
+	stored := make([]quiethours.Window, 0, len(windows))
+	for _, window := range windows {
+		stored = append(stored, quiethours.Window{Start: window.GetStart(), End: window.GetEnd()})
+	}
+
+	doc, err := json.Marshal(stored)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal quiet hours: %w", err)
+	}
+
+	return doc, nil
+}
+
+func unmarshalQuietHours(raw []byte) ([]*rpcv1.QuietWindow, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var stored []quiethours.Window
+	if err := json.Unmarshal(raw, &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal quiet hours: %w", err)
+	}
+
+	windows := make([]*rpcv1.QuietWindow, 0, len(stored))
+	for _, window := range stored {
+		windows = append(windows, &rpcv1.QuietWindow{Start: window.Start, End: window.End})
+	}
+
+	return windows, nil
+}
+
+// quietSchedule builds the schedule of stored settings, nil when there are no
+// quiet hours.
+func quietSchedule(timezone string, raw []byte) (*quiethours.Schedule, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var windows []quiethours.Window
+	if err := json.Unmarshal(raw, &windows); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal quiet hours: %w", err)
+	}
+
+	if len(windows) == 0 {
+		return nil, nil
+	}
+
+	return quiethours.New(timezone, windows)
+}
+
+// deferQuietRecipients sets deliver_after of the queued recipients of the
+// notification whose quiet hours include deliverAt, the time the notification
+// is handed to the orchestrator.
+func (r *postgresRep) deferQuietRecipients(
+	ctx context.Context,
+	tx pgx.Tx,
+	notificationID string,
+	createdAt time.Time,
+	deliverAt time.Time,
+) error {
+	query := r.sb.
+		Select("r.id", effectiveTimezone, effectiveQuietHours).
+		From("notification_recipients r").
+		Join("users u ON u.id = r.user_id").
+		Join("systems s ON s.id = u.system_id").
+		Where(sq.Eq{
+			"r.notification_id": notificationID,
+			"r.created_at":      createdAt,
+			"r.status":          deliveryStatusQueued,
+		}).
+		Where(effectiveQuietHours + " IS NOT NULL")
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build quiet recipients query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return fmt.Errorf("failed to load quiet recipients: %w", err)
+	}
+
+	var (
+		recipientIDs []string
+		deliverAfter []time.Time
+	)
+
+	for rows.Next() {
+		var (
+			recipientID string
+			timezone    string
+			rawQuiet    []byte
+			schedule    *quiethours.Schedule
+		)
+
+		if err = rows.Scan(&recipientID, &timezone, &rawQuiet); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan quiet recipient: %w", err)
+		}
+
+		schedule, err = quietSchedule(timezone, rawQuiet)
+		if err != nil {
+			rows.Close()
+			return fmt.Errorf("invalid quiet hours of recipient %s: %w", recipientID, err)
+		}
+
+		if schedule == nil {
+			continue
+		}
+
+		if after, deferred := schedule.DeliverAfter(deliverAt); deferred {
+			recipientIDs = append(recipientIDs, recipientID)
+			deliverAfter = append(deliverAfter, after)
+		}
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate quiet recipients: %w", err)
+	}
+
+	if len(recipientIDs) == 0 {
+		return nil
+	}
+
+	updateQuery := r.sb.
+		Update("notification_recipients r").
+		Set("deliver_after", sq.Expr("d.deliver_after")).
+		FromSelect(r.sb.Select().
+			Column("unnest(?::uuid[]) AS id", recipientIDs).
+			Column("unnest(?::timestamptz[]) AS deliver_after", deliverAfter), "d").
+		Where("r.id = d.id").
+		Where(sq.Eq{"r.notification_id": notificationID, "r.created_at": createdAt})
+
+	updateSql, updateArgs, err := updateQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build defer recipients query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, updateSql, updateArgs...); err != nil {
+		return fmt.Errorf("failed to defer recipients: %w", err)
+	}
+
+	return nil
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,363 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+const (
+	quotaColumns = "system_id, daily_limit, monthly_limit, soft_limit_percent, updated_at"
+
+	usagePeriodDay   = "day"
+	usagePeriodMonth = "month"
+
+	foreignKeyViolationCode = "23503"
+)
+
+type QuotaRepository interface {
+	SetQuota(ctx context.Context, quota *rpcv1.Quota) (*rpcv1.Quota, error)
+	GetUsage(ctx context.Context, systemID string, now time.Time) (*rpcv1.Usage, error)
+}
+
+// QuotaWarningEvent is published once per period when the usage of a system
+// reaches the soft limit share of its quota.
+type QuotaWarningEvent struct {
+	SystemID         string    `json:"system_id"`
+	Period           string    `json:"period"`
+	PeriodStart      time.Time `json:"period_start"`
+	Used             int64     `json:"used"`
+	Limit            int64     `json:"limit"`
+	SoftLimitPercent int32     `json:"soft_limit_percent"`
+	OccurredAt       time.Time `json:"occurred_at"`
+}
+
+// SetQuota creates or replaces the quota of a system.
+func (r *postgresRep) SetQuota(ctx context.Context, quota *rpcv1.Quota) (*rpcv1.Quota, error) {
+	now := time.Now().UTC()
+
+	query := r.sb.
+		Insert("system_quotas").
+		Columns("system_id", "daily_limit", "monthly_limit", "soft_limit_percent", "created_at", "updated_at").
+		Values(quota.GetSystemId(), quota.GetDailyLimit(), quota.GetMonthlyLimit(), quota.GetSoftLimitPercent(), now, now).
+		Suffix(`ON CONFLICT (system_id) DO UPDATE SET
+			daily_limit = EXCLUDED.daily_limit,
+			monthly_limit = EXCLUDED.monthly_limit,
+			soft_limit_percent = EXCLUDED.soft_limit_percent,
+			updated_at = EXCLUDED.updated_at
+		RETURNING ` + quotaColumns)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := scanQuota(r.pool.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, systemNotFound(quota.GetSystemId())
+		}
+
+		return nil, err
+	}
+
+	return stored, nil
+}
+
+// GetUsage reports the quota of a system and how many notifications it has
+// created in the UTC day and month containing now.
+func (r *postgresRep) GetUsage(ctx context.Context, systemID string, now time.Time) (*rpcv1.Usage, error) {
+	existsQuery := r.sb.
+		Select("1").
+		From("systems").
+		Where(sq.Eq{"id": systemID})
+
+	existsSql, existsArgs, err := existsQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var exists int
+
+	if err = r.pool.QueryRow(ctx, existsSql, existsArgs...).Scan(&exists); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, systemNotFound(systemID)
+		}
+
+		return nil, err
+	}
+
+	quota, err := r.loadQuota(ctx, r.pool, systemID)
+	if err != nil {
+		return nil, err
+	}
+
+	dayStart, monthStart := usagePeriodStarts(now)
+
+	usageQuery := r.sb.
+		Select("period", "used").
+		From("system_usage").
+		Where(sq.Eq{"system_id": systemID}).
+		Where(sq.Or{
+			sq.Eq{"period": usagePeriodDay, "period_start": dayStart},
+			sq.Eq{"period": usagePeriodMonth, "period_start": monthStart},
+		})
+
+	usageSql, usageArgs, err := usageQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, usageSql, usageArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	usage := &rpcv1.Usage{
+		SystemId:   systemID,
+		Quota:      quota,
+		DayStart:   dayStart.Unix(),
+		MonthStart: monthStart.Unix(),
+	}
+
+	for rows.Next() {
+		var (
+			period string
+			used   int64
+		)
+
+		if err = rows.Scan(&period, &used); err != nil {
+			return nil, err
+		}
+
+		switch period {
+		case usagePeriodDay:
+			usage.DailyUsed = used
+		case usagePeriodMonth:
+			usage.MonthlyUsed = used
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// usageLimit is the limit of a system in one usage period, 0 when unlimited.
+type usageLimit struct {
+	period string
+	start  time.Time
+	limit  int64
+}
+
+// deferredUsage is the usage consumeQuota leaves to recordUsage.
+type deferredUsage struct {
+	systemID string
+	count    int64
+	periods  []usageLimit
+}
+
+// consumeQuota counts count new notifications of the system against the
+// limits of its quota within the caller's transaction. It fails with a
+// QuotaExceededError when a limit would be exceeded, the rollback of the
+// caller then undoes the counting. The usage rows of limited periods stay
+// locked until the transaction ends, so concurrent notifications of the system
+// cannot overdraw the quota. Periods without a limit need no lock, they are
+// returned to be counted by recordUsage once the transaction is committed.
+func (r *postgresRep) consumeQuota(
+	ctx context.Context,
+	tx pgx.Tx,
+	systemID string,
+	now time.Time,
+	count int64,
+) (deferredUsage, error) {
+	quota, err := r.loadQuota(ctx, tx, systemID)
+	if err != nil {
+		return deferredUsage{}, err
+	}
+
+	dayStart, monthStart := usagePeriodStarts(now)
+	deferred := deferredUsage{systemID: systemID, count: count}
+
+	for _, limit := range []usageLimit{
+		{period: usagePeriodDay, start: dayStart, limit: quota.GetDailyLimit()},
+		{period: usagePeriodMonth, start: monthStart, limit: quota.GetMonthlyLimit()},
+	} {
+		if limit.limit <= 0 {
+			deferred.periods = append(deferred.periods, limit)
+			continue
+		}
+
+		if err = r.consumeUsage(ctx, tx, quota, systemID, limit, count, now); err != nil {
+			return deferredUsage{}, err
+		}
+	}
+
+	return deferred, nil
+}
+
+// recordUsage counts the usage deferred by consumeQuota, each period in a
+// statement of its own. The notifications are committed by then, a failure is
+// only logged and leaves the usage short.
+func (r *postgresRep) recordUsage(ctx context.Context, usage deferredUsage) {
+	for _, limit := range usage.periods {
+		sqlStr, args, err := r.usageUpsert(usage.systemID, limit, usage.count).ToSql()
+		if err == nil {
+			_, err = r.pool.Exec(ctx, sqlStr, args...)
+		}
+
+		if err != nil {
+			slog.Warn("failed to record usage", slog.String("system_id", usage.systemID),
+				slog.String("period", limit.period), slog.Any("error", err))
+		}
+	}
+}
+
+// usageUpsert adds count to the usage of the system in the period and returns
+// the new usage and whether the period has been warned about.
+func (r *postgresRep) usageUpsert(systemID string, limit usageLimit, count int64) sq.InsertBuilder {
+	return r.sb.
+		Insert("system_usage").
+		Columns("system_id", "period", "period_start", "used").
+		Values(systemID, limit.period, limit.start, count).
+		Suffix(`ON CONFLICT (system_id, period, period_start) DO UPDATE SET
+			used = system_usage.used + EXCLUDED.used
+		RETURNING used, warned_at IS NOT NULL`)
+}
+
+func (r *postgresRep) consumeUsage(
+	ctx context.Context,
+	tx pgx.Tx,
+	quota *rpcv1.Quota,
+	systemID string,
+	limit usageLimit,
+	count int64,
+	now time.Time,
+) error {
+	period := limit.period
+
+	sqlStr, args, err := r.usageUpsert(systemID, limit, count).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build consume %s usage query: %w", period, err)
+	}
+
+	var (
+		used   int64
+		warned bool
+	)
+
+	if err = tx.QueryRow(ctx, sqlStr, args...).Scan(&used, &warned); err != nil {
+		return fmt.Errorf("failed to consume %s usage: %w", period, err)
+	}
+
+	if used > limit.limit {
+		return apperrors.NewQuotaExceededError(
+			"quota exceeded",
+			fmt.Sprintf("system %s has used %d of its %s limit of %d notifications", systemID, used-count, period, limit.limit),
+		)
+	}
+
+	softLimitPercent := quota.GetSoftLimitPercent()
+	if softLimitPercent <= 0 || warned || used*100 < limit.limit*int64(softLimitPercent) {
+		return nil
+	}
+
+	warnQuery := r.sb.
+		Update("system_usage").
+		Set("warned_at", now).
+		Where(sq.Eq{"system_id": systemID, "period": period, "period_start": limit.start})
+
+	warnSql, warnArgs, err := warnQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build mark %s usage warned query: %w", period, err)
+	}
+
+	if _, err = tx.Exec(ctx, warnSql, warnArgs...); err != nil {
+		return fmt.Errorf("failed to mark %s usage warned: %w", period, err)
+	}
+
+	return r.insertOutboxEvent(ctx, tx, systemID, EventQuotaWarning, QuotaWarningEvent{
+		SystemID:         systemID,
+		Period:           period,
+		PeriodStart:      limit.start,
+		Used:             used,
+		Limit:            limit.limit,
+		SoftLimitPercent: softLimitPercent,
+		OccurredAt:       now,
+	})
+}
+
+// rowQuerier is satisfied by both the pool and transactions.
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// loadQuota returns the quota of the system, or nil when it has none.
+func (r *postgresRep) loadQuota(ctx context.Context, q rowQuerier, systemID string) (*rpcv1.Quota, error) {
+	query := r.sb.
+		Select(quotaColumns).
+		From("system_quotas").
+		Where(sq.Eq{"system_id": systemID})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build load quota query: %w", err)
+	}
+
+	quota, err := scanQuota(q.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to load quota: %w", err)
+	}
+
+	return quota, nil
+}
+
+func scanQuota(row pgx.Row) (*rpcv1.Quota, error) {
+	var (
+		quota     rpcv1.Quota
+		updatedAt time.Time
+	)
+
+	if err := row.Scan(
+		&quota.SystemId, &quota.DailyLimit, &quota.MonthlyLimit, &quota.SoftLimitPercent, &updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	quota.UpdatedAt = updatedAt.Unix()
+
+	return &quota, nil
+}
+
+// usagePeriodStarts returns the starts of the UTC day and month containing now.
+func usagePeriodStarts(now time.Time) (time.Time, time.Time) {
+	year, month, day := now.UTC().Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func systemNotFound(id string) error {
+	return apperrors.NewNotFoundError("system not found", "system "+id+" does not exist")
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+type RateLimitRepository interface {
+	TakeRateLimitToken(ctx context.Context, key string, rate float64, burst int) (bool, time.Duration, error)
+}
+
+// refilledTokens is the bucket content after refilling it for the time passed
+// since its last update. Elapsed time is taken from the database clock, so
+// replicas with skewed clocks share buckets consistently.
+const refilledTokens = `LEAST(?::float8, rate_limit_buckets.tokens +
+	GREATEST(EXTRACT(EPOCH FROM NOW() - rate_limit_buckets.updated_at)::float8, 0) * ?::float8)`
+
+// TakeRateLimitToken takes a token from the bucket stored under key, refilling
+// it at rate tokens per second up to burst first. A missing bucket starts
+// full. When the bucket is empty, nothing is taken and it reports how long
+// until the next token is available.
+func (r *postgresRep) TakeRateLimitToken(
+	ctx context.Context,
+	key string,
+	rate float64,
+	burst int,
+) (bool, time.Duration, error) {
+	capacity := float64(burst)
+
+	takeQuery := r.sb.
+		Insert("rate_limit_buckets").
+		Columns("key", "tokens", "updated_at").
+		Values(key, capacity-1, sq.Expr("NOW()")).
+		Suffix(`ON CONFLICT (key) DO UPDATE SET
+			tokens = `+refilledTokens+` - 1,
+			updated_at = EXCLUDED.updated_at
+		WHERE `+refilledTokens+` >= 1
+		RETURNING key`, capacity, rate, capacity, rate)
+
+	takeSql, takeArgs, err := takeQuery.ToSql()
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to build take rate limit token query: %w", err)
+	}
+
+	var takenKey string
+
+	err = r.pool.QueryRow(ctx, takeSql, takeArgs...).Scan(&takenKey)
+	if err == nil {
+		return true, 0, nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return false, 0, fmt.Errorf("failed to take rate limit token: %w", err)
+	}
+
+	waitQuery := r.sb.
+		Select().
+		Column(sq.Expr("(1 - "+refilledTokens+") / ?::float8", capacity, rate, rate)).
+		From("rate_limit_buckets").
+		Where(sq.Eq{"key": key})
+
+	waitSql, waitArgs, err := waitQuery.ToSql()
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to build rate limit wait query: %w", err)
+	}
+
+	var waitSeconds float64
+
+	if err = r.pool.QueryRow(ctx, waitSql, waitArgs...).Scan(&waitSeconds); err != nil {
+		return false, 0, fmt.Errorf("failed to compute rate limit wait: %w", err)
+	}
+
+	return false, time.Duration(waitSeconds * float64(time.Second)), nil
+}
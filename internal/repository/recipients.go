@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+// resolveUsers looks up users by id at system within the given system and
+// returns their UUIDs along with the set of ids at system that were found.
+func (r *postgresRep) resolveUsers(
+	ctx context.Context,
+	tx pgx.Tx,
+	systemID string,
+	userIDs []string,
+) ([]string, map[string]struct{}, error) {
+	query := r.sb.
+		Select("id", "id_at_system").
+		From("users").
+		Where(sq.Eq{"system_id": systemID}).
+		Where("id_at_system = ANY(?)", userIDs)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build resolve query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve users: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		uuids []string
+		found = make(map[string]struct{}, len(userIDs))
+	)
+
+	for rows.Next() {
+		var uid, idAtSystem string
+		if err = rows.Scan(&uid, &idAtSystem); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan user id: %w", err)
+		}
+
+		uuids = append(uuids, uid)
+		found[idAtSystem] = struct{}{}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to iterate user rows: %w", err)
+	}
+
+	return uuids, found, nil
+}
+
+// insertRecipients adds the given users and the members of the given groups
+// as recipients of the notification. Users reached several ways become a
+// single recipient. createdAt must be the one of the notification, so its
+// recipients land in the matching partition.
+func (r *postgresRep) insertRecipients(
+	ctx context.Context,
+	tx pgx.Tx,
+	notificationID string,
+	createdAt time.Time,
+	systemID string,
+	userUUIDs []string,
+	groupIDs []string,
+) (int64, error) {
+	usersQuery := r.sb.
+		Select().
+		Column("unnest(?::uuid[]) AS user_id", userUUIDs)
+
+	if len(groupIDs) > 0 {
+		membersQuery := sq.
+			Select("m.user_id").
+			From("user_group_members m").
+			Join("user_groups g ON g.id = m.group_id").
+			Where(sq.Eq{"g.system_id": systemID}).
+			Where("m.group_id = ANY(?::uuid[])", groupIDs)
+
+		membersSql, membersArgs, err := membersQuery.ToSql()
+		if err != nil {
+			return 0, fmt.Errorf("failed to build group members query: %w", err)
+		}
+
+		usersQuery = usersQuery.Suffix("UNION "+membersSql, membersArgs...)
+	}
+
+	query := r.sb.
+		Insert("notification_recipients").
+		Columns("notification_id", "created_at", "user_id").
+		Select(r.sb.Select().
+			Column("?::uuid", notificationID).
+			Column("?::timestamptz", createdAt).
+			Column("u.user_id").
+			FromSelect(usersQuery, "u"))
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build insert recipients query: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert notification recipients: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// insertSystemRecipients adds every user of the system matching the optional
+// attribute filter as a recipient of the notification. The users are copied by
+// a single INSERT ... SELECT, so large systems are never loaded into memory.
+func (r *postgresRep) insertSystemRecipients(
+	ctx context.Context,
+	tx pgx.Tx,
+	notificationID string,
+	createdAt time.Time,
+	systemID string,
+	filter *rpcv1.AttributeFilter,
+) (int64, error) {
+	query, err := r.systemRecipientsQuery(notificationID, createdAt, systemID, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build insert system recipients query: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert system recipients: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *postgresRep) systemRecipientsQuery(
+	notificationID string,
+	createdAt time.Time,
+	systemID string,
+	filter *rpcv1.AttributeFilter,
+) (sq.InsertBuilder, error) {
+	usersQuery := r.sb.
+		Select().
+		Column("?::uuid", notificationID).
+		Column("?::timestamptz", createdAt).
+		Column("u.id").
+		From("users u").
+		Where(sq.Eq{"u.system_id": systemID})
+
+	if len(filter.GetConditions()) > 0 {
+		condition, err := attributeFilterToSql("u", filter)
+		if err != nil {
+			return sq.InsertBuilder{}, err
+		}
+
+		usersQuery = usersQuery.Where(condition)
+	}
+
+	return r.sb.
+		Insert("notification_recipients").
+		Columns("notification_id", "created_at", "user_id").
+		Select(usersQuery), nil
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+// ReleaseScheduledNotifications moves up to limit due scheduled notifications
+// to pending and enqueues them for dispatch.
+func (r *postgresRep) ReleaseScheduledNotifications(ctx context.Context, now time.Time, limit int) (int, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	keys, err := r.claimScheduledNotifications(ctx, tx, sq.LtOrEq{"send_at": now}, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(keys) == 0 {
+		_ = tx.Rollback(ctx)
+
+		return 0, nil
+	}
+
+	released, err := r.transitionNotifications(ctx, tx, keys, notificationStatusPending)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, key := range released {
+		if err = r.enqueueDispatch(ctx, tx, key.ID, key.CreatedAt); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(released), nil
+}
+
+// ExpireScheduledNotifications expires up to limit scheduled notifications that
+// were due before the given time but have not been released, e.g. because the
+// service was down. Their recipients are cancelled.
+func (r *postgresRep) ExpireScheduledNotifications(ctx context.Context, dueBefore time.Time, limit int) (int, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	keys, err := r.claimScheduledNotifications(ctx, tx, sq.Lt{"send_at": dueBefore}, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(keys) == 0 {
+		_ = tx.Rollback(ctx)
+
+		return 0, nil
+	}
+
+	expired, err := r.transitionNotifications(ctx, tx, keys, notificationStatusExpired)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().UTC()
+	expiredIDs, expiredCreatedAts := splitNotificationKeys(expired)
+
+	recipientsQuery := r.sb.
+		Update("notification_recipients").
+		Set("status", deliveryStatusCancelled).
+		Set("updated_at", now).
+		Where("notification_id = ANY(?::uuid[])", expiredIDs).
+		Where("created_at = ANY(?::timestamptz[])", expiredCreatedAts).
+		Where(sq.Eq{"status": deliveryStatusQueued})
+
+	recipientsSql, recipientsArgs, err := recipientsQuery.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build cancel recipients query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, recipientsSql, recipientsArgs...); err != nil {
+		return 0, fmt.Errorf("failed to cancel recipients of expired notifications: %w", err)
+	}
+
+	if err = r.dropPendingDigestItems(ctx, tx, expired, now); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(expired), nil
+}
+
+// claimScheduledNotifications locks up to limit scheduled notifications whose
+// send_at matches due and returns their keys. Rows are claimed with SKIP
+// LOCKED, so concurrent schedulers on other replicas pick disjoint batches.
+func (r *postgresRep) claimScheduledNotifications(
+	ctx context.Context,
+	tx pgx.Tx,
+	due sq.Sqlizer,
+	limit int,
+) ([]notificationKey, error) {
+	claimQuery := r.sb.
+		Select("id", "created_at").
+		From("notifications").
+		Where(sq.Eq{"status": notificationStatusScheduled}).
+		Where(due).
+		OrderBy("send_at").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	claimSql, claimArgs, err := claimQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build claim scheduled notifications query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, claimSql, claimArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim scheduled notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []notificationKey
+
+	for rows.Next() {
+		var key notificationKey
+		if err = rows.Scan(&key.ID, &key.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan notification key: %w", err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate scheduled notifications: %w", err)
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+const (
+	templateColumns = "id, system_id, name, body, created_at, updated_at"
+
+	uniqueViolationCode = "23505"
+)
+
+type TemplateRepository interface {
+	CreateTemplate(ctx context.Context, systemID, name, body string) (*rpcv1.Template, error)
+	GetTemplate(ctx context.Context, id string) (*rpcv1.Template, error)
+	ListTemplates(ctx context.Context, systemID string) ([]*rpcv1.Template, error)
+	UpdateTemplate(ctx context.Context, id string, name, body *string) (*rpcv1.Template, error)
+	DeleteTemplate(ctx context.Context, id string) error
+}
+
+func (r *postgresRep) CreateTemplate(ctx context.Context, systemID, name, body string) (*rpcv1.Template, error) {
+	now := time.Now().UTC()
+
+	query := r.sb.
+		Insert("notification_templates").
+		Columns("system_id", "name", "body", "created_at", "updated_at").
+		Values(systemID, name, body, now, now).
+		Suffix("RETURNING " + templateColumns)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := scanTemplate(r.pool.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, apperrors.NewConflictError("template already exists", "template "+name+" already exists in the system")
+		}
+
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (r *postgresRep) GetTemplate(ctx context.Context, id string) (*rpcv1.Template, error) {
+	query := r.sb.
+		Select(templateColumns).
+		From("notification_templates").
+		Where(sq.Eq{"id": id})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := scanTemplate(r.pool.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, templateNotFound(id)
+		}
+
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (r *postgresRep) ListTemplates(ctx context.Context, systemID string) ([]*rpcv1.Template, error) {
+	query := r.sb.
+		Select(templateColumns).
+		From("notification_templates").
+		Where(sq.Eq{"system_id": systemID}).
+		OrderBy("name")
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []*rpcv1.Template
+
+	for rows.Next() {
+		template, err := scanTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		templates = append(templates, template)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+func (r *postgresRep) UpdateTemplate(ctx context.Context, id string, name, body *string) (*rpcv1.Template, error) {
+	query := r.sb.Update("notification_templates").Set("updated_at", time.Now().UTC())
+
+	if name != nil {
+		query = query.Set("name", *name)
+	}
+
+	if body != nil {
+		query = query.Set("body", *body)
+	}
+
+	query = query.Where(sq.Eq{"id": id}).
+		Suffix("RETURNING " + templateColumns)
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := scanTemplate(r.pool.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, templateNotFound(id)
+		case isUniqueViolation(err):
+			return nil, apperrors.NewConflictError("template already exists", "template "+*name+" already exists in the system")
+		default:
+			return nil, err
+		}
+	}
+
+	return template, nil
+}
+
+func (r *postgresRep) DeleteTemplate(ctx context.Context, id string) error {
+	query := r.sb.
+		Delete("notification_templates").
+		Where(sq.Eq{"id": id})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.pool.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return templateNotFound(id)
+	}
+
+	return nil
+}
+
+func scanTemplate(row pgx.Row) (*rpcv1.Template, error) {
+	var (
+		id        string
+		systemID  string
+		name      string
+		body      string
+		createdAt time.Time
+		updatedAt time.Time
+	)
+
+	if err := row.Scan(&id, &systemID, &name, &body, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	return &rpcv1.Template{
+		Id:        id,
+		SystemId:  systemID,
+		Name:      name,
+		Body:      body,
+		CreatedAt: createdAt.Unix(),
+		UpdatedAt: updatedAt.Unix(),
+	}, nil
+}
+
+func templateNotFound(id string) error {
+	return apperrors.NewNotFoundError("template not found", "template "+id+" does not exist")
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
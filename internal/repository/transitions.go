@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+)
+
+// Notification lifecycle:
+//
+//	scheduled -> pending | sent | cancelled | expired
+//	pending -> queued | sending | sent | partially_failed | failed | cancelled
+//	queued -> sending | sent | partially_failed | failed | cancelled
+//	sending -> sent | partially_failed | failed | cancelled
+//	partially_failed -> sending | sent
+//	failed -> sending | sent | partially_failed
+//
+// sent, cancelled and expired are final. Every status change of an existing
+// notification goes through transitionNotification or transitionNotifications,
+// which only update rows that are in one of the allowed source statuses.
+
+// notificationStatusSources returns the statuses a notification may move to the
+// given status from.
+func notificationStatusSources(to string) []string {
+	switch to {
+	case notificationStatusPending:
+		return []string{notificationStatusScheduled}
+	case notificationStatusQueued:
+		return []string{notificationStatusPending}
+	case notificationStatusSending:
+		return []string{
+			notificationStatusPending, notificationStatusQueued,
+			notificationStatusPartiallyFailed, notificationStatusFailed,
+		}
+	case notificationStatusSent:
+		// scheduled -> sent completes a notification that has nothing left to
+		// deliver when it is created, e.g. every recipient is deduplicated.
+		return []string{
+			notificationStatusScheduled,
+			notificationStatusPending, notificationStatusQueued, notificationStatusSending,
+			notificationStatusPartiallyFailed, notificationStatusFailed,
+		}
+	case notificationStatusPartiallyFailed:
+		return []string{
+			notificationStatusPending, notificationStatusQueued, notificationStatusSending,
+			notificationStatusFailed,
+		}
+	case notificationStatusFailed:
+		return []string{notificationStatusPending, notificationStatusQueued, notificationStatusSending}
+	case notificationStatusCancelled:
+		return []string{
+			notificationStatusScheduled, notificationStatusPending,
+			notificationStatusQueued, notificationStatusSending,
+		}
+	case notificationStatusExpired:
+		return []string{notificationStatusScheduled}
+	default:
+		return nil
+	}
+}
+
+func isFinalNotificationStatus(status string) bool {
+	switch status {
+	case notificationStatusSent, notificationStatusCancelled, notificationStatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+// transitionNotification moves the notification to the given status. Moving to
+// the current status is a no-op, a move the lifecycle does not allow fails with
+// apperrors.InvalidTransitionError.
+func (r *postgresRep) transitionNotification(
+	ctx context.Context,
+	tx pgx.Tx,
+	id string,
+	createdAt time.Time,
+	to string,
+) error {
+	updateQuery := r.sb.
+		Update("notifications").
+		Set("status", to).
+		Where(sq.Eq{"id": id, "created_at": createdAt, "status": notificationStatusSources(to)})
+
+	updateSql, updateArgs, err := updateQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build notification transition query: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, updateSql, updateArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to move notification to %s: %w", to, err)
+	}
+
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	statusQuery := r.sb.
+		Select("status").
+		From("notifications").
+		Where(sq.Eq{"id": id, "created_at": createdAt})
+
+	statusSql, statusArgs, err := statusQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build notification status query: %w", err)
+	}
+
+	var current string
+
+	if err = tx.QueryRow(ctx, statusSql, statusArgs...).Scan(&current); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperrors.NewNotFoundError("notification not found", "notification "+id+" does not exist")
+		}
+
+		return fmt.Errorf("failed to load notification status: %w", err)
+	}
+
+	if current == to {
+		return nil
+	}
+
+	return apperrors.NewInvalidTransitionError("notification", id, current, to)
+}
+
+// transitionNotifications moves every notification of keys that is allowed to
+// reach the given status and returns the keys that were moved. The rest is left
+// untouched.
+func (r *postgresRep) transitionNotifications(
+	ctx context.Context,
+	tx pgx.Tx,
+	keys []notificationKey,
+	to string,
+) ([]notificationKey, error) {
+	ids, createdAts := splitNotificationKeys(keys)
+
+	query := r.sb.
+		Update("notifications").
+		Set("status", to).
+		Where("id = ANY(?::uuid[])", ids).
+		Where("created_at = ANY(?::timestamptz[])", createdAts).
+		Where(sq.Eq{"status": notificationStatusSources(to)}).
+		Suffix("RETURNING id, created_at")
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build notifications transition query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move notifications to %s: %w", to, err)
+	}
+	defer rows.Close()
+
+	var moved []notificationKey
+
+	for rows.Next() {
+		var key notificationKey
+		if err = rows.Scan(&key.ID, &key.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan notification key: %w", err)
+		}
+
+		moved = append(moved, key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate moved notifications: %w", err)
+	}
+
+	return moved, nil
+}
+
+// syncNotificationStatus derives the notification status from the statuses of
+// its recipients and moves the notification there. Recipients that were never
+// meant to be delivered to, e.g. cancelled ones, are not taken into account.
+// The caller must hold a lock on the notification row, so concurrent reports
+// do not derive the status from stale counts.
+func (r *postgresRep) syncNotificationStatus(
+	ctx context.Context,
+	tx pgx.Tx,
+	id string,
+	createdAt time.Time,
+	current string,
+) error {
+	if isFinalNotificationStatus(current) {
+		return nil
+	}
+
+	// The counters are kept up to date by triggers on notification_recipients.
+	query := r.sb.
+		Select("recipients_queued", "recipients_sending", "recipients_delivered", "recipients_failed").
+		From("notifications").
+		Where(sq.Eq{"id": id, "created_at": createdAt})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build recipient counts query: %w", err)
+	}
+
+	var queued, sending, delivered, failed int64
+
+	if err = tx.QueryRow(ctx, sqlStr, args...).Scan(&queued, &sending, &delivered, &failed); err != nil {
+		return fmt.Errorf("failed to load recipient counts: %w", err)
+	}
+
+	var target string
+
+	switch {
+	case queued+sending+delivered+failed == 0:
+		return nil
+	case sending > 0 || (queued > 0 && delivered+failed > 0):
+		target = notificationStatusSending
+	case queued > 0:
+		return nil
+	case failed == 0:
+		target = notificationStatusSent
+	case delivered == 0:
+		target = notificationStatusFailed
+	default:
+		target = notificationStatusPartiallyFailed
+	}
+
+	if target == current {
+		return nil
+	}
+
+	return r.transitionNotification(ctx, tx, id, createdAt, target)
+}
@@ -12,13 +12,17 @@ import (
 	"go.opencensus.io/stats/view"
 	"google.golang.org/grpc"
 
+	"github.com/notification-system-moxicom/persistence-service/internal/ratelimit"
 	"github.com/notification-system-moxicom/persistence-service/internal/repository"
 	"github.com/notification-system-moxicom/persistence-service/internal/service"
+	"github.com/notification-system-moxicom/persistence-service/internal/unsubscribe"
+	"github.com/notification-system-moxicom/persistence-service/internal/watch"
 	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
 )
 
 type GRPCConfig struct {
-	Port string `yaml:"port"`
+	Port      string           `yaml:"port"`
+	RateLimit ratelimit.Config `yaml:"rate_limit"`
 }
 
 type GRPC struct {
@@ -43,15 +47,19 @@ func (s *GRPC) Ready(w http.ResponseWriter, r *http.Request, _ map[string]string
 func NewGRPC(
 	config *GRPCConfig,
 	rep repository.Repository,
+	hub *watch.Hub,
+	limiter ratelimit.Limiter,
+	signer *unsubscribe.Signer,
 ) *GRPC {
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			grpcValidator.UnaryServerInterceptor(),
+			ratelimit.UnaryServerInterceptor(limiter, rep, config.RateLimit.Limits),
 		),
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
 	)
 
-	functionSrv := service.NewService(rep)
+	functionSrv := service.NewService(rep, hub, signer)
 
 	rpcv1.RegisterPersistenceServiceServer(grpcServer, functionSrv)
 
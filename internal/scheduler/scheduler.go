@@ -0,0 +1,164 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/notification-system-moxicom/persistence-service/pkg/util/generic"
+)
+
+const (
+	defaultInterval       = 5 * time.Second
+	defaultBatchSize      = 500
+	defaultEventRetention = 24 * time.Hour
+	defaultDigestTemplate = "digest"
+)
+
+type Config struct {
+	Interval  time.Duration `yaml:"interval"`
+	BatchSize int           `yaml:"batch_size"`
+
+	// ExpireAfter is how late a scheduled notification may be released. Older
+	// ones expire instead of being sent. Zero disables expiration.
+	ExpireAfter time.Duration `yaml:"expire_after"`
+
+	// EventRetention is how long notification events are kept for watchers
+	// resuming from a cursor.
+	EventRetention time.Duration `yaml:"event_retention"`
+
+	// DigestTemplate is the name of the system template digests are rendered
+	// from. Systems without one get a built-in body.
+	DigestTemplate string `yaml:"digest_template"`
+}
+
+type Store interface {
+	ReleaseScheduledNotifications(ctx context.Context, now time.Time, limit int) (int, error)
+	ExpireScheduledNotifications(ctx context.Context, dueBefore time.Time, limit int) (int, error)
+	DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error)
+	DeleteNotificationEvents(ctx context.Context, olderThan time.Time) (int64, error)
+	RollupDigests(ctx context.Context, now time.Time, templateName string, limit int) (int, error)
+}
+
+// Scheduler periodically hands due scheduled notifications over to dispatch,
+// expires the ones that are overdue, rolls due digest items up into digests and
+// cleans up expired idempotency keys and old notification events. Every
+// replica may run one: claims are made with row locks, so the work is split
+// between them.
+type Scheduler struct {
+	store  Store
+	config Config
+}
+
+func New(store Store, cfg Config) *Scheduler {
+	cfg.Interval = generic.DefaultIfZero(cfg.Interval, defaultInterval)
+	cfg.BatchSize = generic.DefaultIfZero(cfg.BatchSize, defaultBatchSize)
+	cfg.EventRetention = generic.DefaultIfZero(cfg.EventRetention, defaultEventRetention)
+	cfg.DigestTemplate = generic.DefaultIfZero(cfg.DigestTemplate, defaultDigestTemplate)
+
+	return &Scheduler{
+		store:  store,
+		config: cfg,
+	}
+}
+
+// Run executes scheduler ticks until ctx is canceled.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	slog.Info("scheduler started", slog.Duration("interval", s.config.Interval))
+
+	for {
+		s.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			slog.Info("context canceled, stopping scheduler")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) tick(ctx context.Context) {
+	if s.config.ExpireAfter > 0 {
+		s.expireScheduled(ctx)
+	}
+
+	s.releaseScheduled(ctx)
+	s.rollupDigests(ctx)
+
+	deleted, err := s.store.DeleteExpiredIdempotencyKeys(ctx, time.Now().UTC())
+	if err != nil {
+		slog.Error("failed to delete expired idempotency keys", slog.Any("error", err))
+	} else if deleted > 0 {
+		slog.Debug("deleted expired idempotency keys", slog.Int64("count", deleted))
+	}
+
+	deleted, err = s.store.DeleteNotificationEvents(ctx, time.Now().UTC().Add(-s.config.EventRetention))
+	if err != nil {
+		slog.Error("failed to delete old notification events", slog.Any("error", err))
+	} else if deleted > 0 {
+		slog.Debug("deleted old notification events", slog.Int64("count", deleted))
+	}
+}
+
+// releaseScheduled drains due notifications batch by batch.
+func (s *Scheduler) releaseScheduled(ctx context.Context) {
+	for ctx.Err() == nil {
+		released, err := s.store.ReleaseScheduledNotifications(ctx, time.Now().UTC(), s.config.BatchSize)
+		if err != nil {
+			slog.Error("failed to release scheduled notifications", slog.Any("error", err))
+			return
+		}
+
+		if released > 0 {
+			slog.Info("released scheduled notifications", slog.Int("count", released))
+		}
+
+		if released < s.config.BatchSize {
+			return
+		}
+	}
+}
+
+// rollupDigests drains due digest items batch by batch.
+func (s *Scheduler) rollupDigests(ctx context.Context) {
+	for ctx.Err() == nil {
+		digests, err := s.store.RollupDigests(ctx, time.Now().UTC(), s.config.DigestTemplate, s.config.BatchSize)
+		if err != nil {
+			slog.Error("failed to roll up digests", slog.Any("error", err))
+			return
+		}
+
+		if digests > 0 {
+			slog.Info("rolled up digests", slog.Int("count", digests))
+		}
+
+		if digests < s.config.BatchSize {
+			return
+		}
+	}
+}
+
+// expireScheduled drains overdue notifications batch by batch.
+func (s *Scheduler) expireScheduled(ctx context.Context) {
+	for ctx.Err() == nil {
+		dueBefore := time.Now().UTC().Add(-s.config.ExpireAfter)
+
+		expired, err := s.store.ExpireScheduledNotifications(ctx, dueBefore, s.config.BatchSize)
+		if err != nil {
+			slog.Error("failed to expire scheduled notifications", slog.Any("error", err))
+			return
+		}
+
+		if expired > 0 {
+			slog.Warn("expired overdue scheduled notifications", slog.Int("count", expired))
+		}
+
+		if expired < s.config.BatchSize {
+			return
+		}
+	}
+}
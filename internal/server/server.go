@@ -29,6 +29,7 @@ type HTTPConfig struct {
 
 type HTTPHandlers interface {
 	api.ServerInterface
+	Unsubscribe(w http.ResponseWriter, r *http.Request)
 }
 type Server struct {
 	httpServer *http.Server
@@ -56,6 +57,8 @@ func (s *Server) AddHTTPServer(c HTTPConfig) {
 	mux.Use(middleware.SetHeader("Content-Type", applicationJSONContentType))
 	mux.Use(cors.Handler(corsOptions))
 
+	mux.Post("/unsubscribe", s.handlers.Unsubscribe)
+
 	mux.Route("/api/api-gateway/v1", func(r chi.Router) {
 		r.Mount("/", api.Handler(s.handlers)) // TODO: fixme. replace nil with s.handlers
 	})
@@ -67,6 +70,14 @@ func (s *Server) AddHTTPServer(c HTTPConfig) {
 	}
 }
 
+// Listen serves HTTP until the server fails, leaving signal handling to the
+// caller.
+func (s *Server) Listen() error {
+	slog.Info("http server started", "address", s.httpServer.Addr)
+
+	return s.httpServer.ListenAndServe()
+}
+
 func (s *Server) Run() {
 	// Create a channel to listen for OS signals
 	stop := make(chan os.Signal, 1)
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	"github.com/notification-system-moxicom/persistence-service/internal/repository"
+	"github.com/notification-system-moxicom/persistence-service/internal/templating"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+const maxBatchNotifyItems = 5000
+
+// BatchNotify creates a notification per item. Items that fail validation,
+// template rendering or recipient resolution are reported in their result,
+// the rest is stored in one transaction.
+func (s *grpcService) BatchNotify(
+	ctx context.Context,
+	request *rpcv1.BatchNotifyRequest,
+) (*rpcv1.BatchNotifyResponse, error) {
+	if err := validateBatchNotifyRequest(request); err != nil {
+		return nil, statusError(err, "invalid batch notify request")
+	}
+
+	var (
+		systemID  = request.GetSystemId()
+		results   = make([]*rpcv1.BatchNotifyResult, len(request.GetItems()))
+		items     = make([]repository.NotificationBatchItem, 0, len(request.GetItems()))
+		positions = make([]int, 0, len(request.GetItems())) // request index of every item in items
+		templates = make(map[string]templateLookup)
+	)
+
+	for i, spec := range request.GetItems() {
+		item, err := s.batchItem(ctx, systemID, spec, templates)
+		if err != nil {
+			results[i] = &rpcv1.BatchNotifyResult{Error: itemError(err, "failed to prepare notification")}
+			continue
+		}
+
+		items = append(items, item)
+		positions = append(positions, i)
+	}
+
+	if len(items) > 0 {
+		stored, err := s.repo.CreateNotificationBatch(ctx, systemID, items)
+		if err != nil {
+			slog.Error("create notification batch failed", "system_id", systemID, "items", len(items), "error", err)
+			return nil, statusError(err, "failed to create notifications")
+		}
+
+		for j, result := range stored {
+			batchResult := &rpcv1.BatchNotifyResult{
+				NotificationId:    result.NotificationID,
+				ResolvedUserIds:   result.Resolved,
+				UnresolvedUserIds: result.Unresolved,
+				RecipientCount:    result.RecipientCount,
+				SuppressedCount:   result.SuppressedCount,
+				DigestedCount:     result.DigestedCount,
+				DeduplicatedCount: result.DeduplicatedCount,
+			}
+
+			if result.Err != nil {
+				batchResult.Error = itemError(result.Err, "failed to create notification")
+			}
+
+			results[positions[j]] = batchResult
+		}
+	}
+
+	return &rpcv1.BatchNotifyResponse{Results: results}, nil
+}
+
+// templateLookup caches the outcome of loading a template for a batch, so
+// items sharing a template load it once.
+type templateLookup struct {
+	template *rpcv1.Template
+	err      error
+}
+
+// batchItem validates the spec and resolves its content.
+func (s *grpcService) batchItem(
+	ctx context.Context,
+	systemID string,
+	spec *rpcv1.NotificationSpec,
+	templates map[string]templateLookup,
+) (repository.NotificationBatchItem, error) {
+	if err := validateNotificationSpec(spec); err != nil {
+		return repository.NotificationBatchItem{}, err
+	}
+
+	item := repository.NotificationBatchItem{
+		UserIDs:          spec.GetUserIds(),
+		Content:          spec.GetContent(),
+		StrictRecipients: spec.GetStrictRecipients(),
+		Urgent:           spec.GetPriority() == rpcv1.Priority_PRIORITY_URGENT,
+		Category:         spec.GetCategory(),
+		LowPriority:      spec.GetPriority() == rpcv1.Priority_PRIORITY_LOW,
+	}
+
+	if structured := spec.GetStructuredContent(); structured != nil {
+		item.Content = structured.GetText()
+		item.StructuredContent = structured
+	}
+
+	if templateID := spec.GetTemplateId(); templateID != "" {
+		lookup, ok := templates[templateID]
+		if !ok {
+			lookup.template, lookup.err = s.systemTemplate(ctx, systemID, templateID)
+			templates[templateID] = lookup
+		}
+
+		if lookup.err != nil {
+			return repository.NotificationBatchItem{}, lookup.err
+		}
+
+		content, err := templating.Render(lookup.template.GetBody(), spec.GetVariables())
+		if err != nil {
+			return repository.NotificationBatchItem{}, err
+		}
+
+		item.Content = content
+	}
+
+	if spec.GetSendAt() > 0 {
+		item.SendAt = time.Unix(spec.GetSendAt(), 0).UTC()
+	}
+
+	return item, nil
+}
+
+func validateBatchNotifyRequest(req *rpcv1.BatchNotifyRequest) error {
+	var details []string
+
+	if req.GetSystemId() == "" {
+		details = append(details, "system_id is required")
+	} else if _, err := uuid.Parse(req.GetSystemId()); err != nil {
+		details = append(details, "system_id must be a valid UUID")
+	}
+
+	switch n := len(req.GetItems()); {
+	case n == 0:
+		details = append(details, "items must not be empty")
+	case n > maxBatchNotifyItems:
+		details = append(details, fmt.Sprintf("items must not contain more than %d entries", maxBatchNotifyItems))
+	}
+
+	if len(details) > 0 {
+		return apperrors.NewValidationError("invalid batch notify request", details...)
+	}
+
+	return nil
+}
+
+func validateNotificationSpec(spec *rpcv1.NotificationSpec) error {
+	var details []string
+
+	if len(spec.GetUserIds()) == 0 {
+		details = append(details, "user_ids must not be empty")
+	}
+
+	details = append(details, validateNotifyContent(spec)...)
+
+	if spec.GetSendAt() < 0 {
+		details = append(details, "send_at must be a unix timestamp")
+	}
+
+	if _, ok := rpcv1.Priority_name[int32(spec.GetPriority())]; !ok {
+		details = append(details, "priority is not supported")
+	}
+
+	if len(spec.GetCategory()) > maxCategoryLength {
+		details = append(details, "category must not be longer than 64 characters")
+	}
+
+	if len(details) > 0 {
+		return apperrors.NewValidationError("invalid notification spec", details...)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+func (s *grpcService) CreateCategory(ctx context.Context, request *rpcv1.CreateCategoryRequest) (*rpcv1.Category, error) {
+	details := validateCategoryKey(request.GetSystemId(), request.GetName())
+	if !validDigestMode(request.GetDigestMode()) {
+		details = append(details, "digest_mode must be a supported digest mode")
+	}
+
+	if len(details) > 0 {
+		err := apperrors.NewValidationError("invalid create category request", details...)
+		return nil, statusError(err, "invalid create category request")
+	}
+
+	category, err := s.repo.CreateCategory(ctx, &rpcv1.Category{
+		SystemId:     request.GetSystemId(),
+		Name:         request.GetName(),
+		Description:  request.GetDescription(),
+		DefaultOptIn: request.DefaultOptIn == nil || request.GetDefaultOptIn(),
+		Mandatory:    request.GetMandatory(),
+		DigestMode:   request.GetDigestMode(),
+	})
+	if err != nil {
+		slog.Error("create category failed", "system_id", request.GetSystemId(), "name", request.GetName(), "error", err)
+		return nil, statusError(err, "failed to create category")
+	}
+
+	return category, nil
+}
+
+func (s *grpcService) ListCategories(ctx context.Context, request *rpcv1.ListCategoriesRequest) (*rpcv1.Categories, error) {
+	if _, err := uuid.Parse(request.GetSystemId()); err != nil {
+		err = apperrors.NewValidationError("invalid list categories request", "system_id must be a valid UUID")
+		return nil, statusError(err, "invalid list categories request")
+	}
+
+	categories, err := s.repo.ListCategories(ctx, request.GetSystemId())
+	if err != nil {
+		slog.Error("list categories failed", "system_id", request.GetSystemId(), "error", err)
+		return nil, statusError(err, "failed to list categories")
+	}
+
+	return &rpcv1.Categories{Categories: categories}, nil
+}
+
+func (s *grpcService) UpdateCategory(ctx context.Context, request *rpcv1.UpdateCategoryRequest) (*rpcv1.Category, error) {
+	details := validateCategoryKey(request.GetSystemId(), request.GetName())
+	if request.DigestMode != nil && !validDigestMode(request.GetDigestMode()) {
+		details = append(details, "digest_mode must be a supported digest mode")
+	}
+
+	if len(details) > 0 {
+		err := apperrors.NewValidationError("invalid update category request", details...)
+		return nil, statusError(err, "invalid update category request")
+	}
+
+	category, err := s.repo.UpdateCategory(
+		ctx,
+		request.GetSystemId(),
+		request.GetName(),
+		request.Description,
+		request.DefaultOptIn,
+		request.Mandatory,
+		request.DigestMode,
+	)
+	if err != nil {
+		slog.Error("update category failed", "system_id", request.GetSystemId(), "name", request.GetName(), "error", err)
+		return nil, statusError(err, "failed to update category")
+	}
+
+	return category, nil
+}
+
+func (s *grpcService) DeleteCategory(ctx context.Context, request *rpcv1.DeleteCategoryRequest) (*rpcv1.InfoMessage, error) {
+	details := validateCategoryKey(request.GetSystemId(), request.GetName())
+	if len(details) > 0 {
+		err := apperrors.NewValidationError("invalid delete category request", details...)
+		return nil, statusError(err, "invalid delete category request")
+	}
+
+	if err := s.repo.DeleteCategory(ctx, request.GetSystemId(), request.GetName()); err != nil {
+		slog.Error("delete category failed", "system_id", request.GetSystemId(), "name", request.GetName(), "error", err)
+		return nil, statusError(err, "failed to delete category")
+	}
+
+	return &rpcv1.InfoMessage{Message: "category deleted"}, nil
+}
+
+func validateCategoryKey(systemID, name string) []string {
+	var details []string
+
+	if _, err := uuid.Parse(systemID); err != nil {
+		details = append(details, "system_id must be a valid UUID")
+	}
+
+	if name == "" {
+		details = append(details, "name is required")
+	} else if len(name) > maxCategoryLength {
+		details = append(details, "name must not be longer than 64 characters")
+	}
+
+	return details
+}
+
+// validDigestMode reports whether mode is a known digest mode. Unspecified is
+// valid, it leaves the mode unset.
+func validDigestMode(mode rpcv1.DigestMode) bool {
+	_, ok := rpcv1.DigestMode_name[int32(mode)]
+
+	return ok
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+// statusError converts application errors into gRPC status errors. Errors that
+// carry details are returned with a ValidationErrorResponse attached, anything
+// unrecognized becomes codes.Internal prefixed with msg.
+func statusError(err error, msg string) error {
+	var (
+		validationErr apperrors.ValidationError
+		notFoundErr   apperrors.NotFoundError
+		conflictErr   apperrors.ConflictError
+		transitionErr apperrors.InvalidTransitionError
+		quotaErr      apperrors.QuotaExceededError
+	)
+
+	switch {
+	case errors.As(err, &validationErr):
+		return statusWithDetails(codes.InvalidArgument, validationErr.Error(), validationErr.Details())
+	case errors.As(err, &notFoundErr):
+		return statusWithDetails(codes.NotFound, notFoundErr.Error(), notFoundErr.Details())
+	case errors.As(err, &conflictErr):
+		return statusWithDetails(codes.AlreadyExists, conflictErr.Error(), conflictErr.Details())
+	case errors.As(err, &transitionErr):
+		return statusWithDetails(codes.FailedPrecondition, transitionErr.Error(), transitionErr.Details())
+	case errors.As(err, &quotaErr):
+		return statusWithDetails(codes.ResourceExhausted, quotaErr.Error(), quotaErr.Details())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
+
+func statusWithDetails(code codes.Code, msg string, details []string) error {
+	st := status.New(code, msg)
+	if len(details) == 0 {
+		return st.Err()
+	}
+
+	withDetails, err := st.WithDetails(&rpcv1.ValidationErrorResponse{
+		Error:   msg,
+		Details: details,
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
+
+// itemError describes why a batch item was rejected, with the code and details
+// the single-item RPC would have failed with.
+func itemError(err error, msg string) *rpcv1.ItemError {
+	st := status.Convert(statusError(err, msg))
+
+	itemErr := &rpcv1.ItemError{
+		Code:    st.Code().String(),
+		Message: st.Message(),
+	}
+
+	for _, detail := range st.Details() {
+		if validationErr, ok := detail.(*rpcv1.ValidationErrorResponse); ok {
+			itemErr.Details = validationErr.GetDetails()
+		}
+	}
+
+	return itemErr
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+func (s *grpcService) CreateGroup(ctx context.Context, request *rpcv1.CreateGroupRequest) (*rpcv1.Group, error) {
+	var details []string
+
+	if _, err := uuid.Parse(request.GetSystemId()); err != nil {
+		details = append(details, "system_id must be a valid UUID")
+	}
+
+	if request.GetName() == "" {
+		details = append(details, "name is required")
+	}
+
+	if len(details) > 0 {
+		return nil, statusError(apperrors.NewValidationError("invalid create group request", details...), "invalid create group request")
+	}
+
+	group, err := s.repo.CreateGroup(ctx, request.GetSystemId(), request.GetName())
+	if err != nil {
+		slog.Error("create group failed", "system_id", request.GetSystemId(), "name", request.GetName(), "error", err)
+		return nil, statusError(err, "failed to create group")
+	}
+
+	return group, nil
+}
+
+func (s *grpcService) DeleteGroup(ctx context.Context, request *rpcv1.DeleteGroupRequest) (*rpcv1.InfoMessage, error) {
+	if _, err := uuid.Parse(request.GetId()); err != nil {
+		return nil, statusError(apperrors.NewValidationError("invalid delete group request", "id must be a valid UUID"), "invalid delete group request")
+	}
+
+	if err := s.repo.DeleteGroup(ctx, request.GetId()); err != nil {
+		slog.Error("delete group failed", "id", request.GetId(), "error", err)
+		return nil, statusError(err, "failed to delete group")
+	}
+
+	return &rpcv1.InfoMessage{Message: "group deleted"}, nil
+}
+
+func (s *grpcService) AddGroupMembers(
+	ctx context.Context,
+	request *rpcv1.GroupMembersRequest,
+) (*rpcv1.GroupMembersResponse, error) {
+	if err := validateGroupMembersRequest(request); err != nil {
+		return nil, statusError(err, "invalid add group members request")
+	}
+
+	result, err := s.repo.AddGroupMembers(ctx, request.GetGroupId(), request.GetUserIds())
+	if err != nil {
+		slog.Error("add group members failed", "group_id", request.GetGroupId(), "error", err)
+		return nil, statusError(err, "failed to add group members")
+	}
+
+	return &rpcv1.GroupMembersResponse{
+		GroupId:           request.GetGroupId(),
+		ResolvedUserIds:   result.Resolved,
+		UnresolvedUserIds: result.Unresolved,
+	}, nil
+}
+
+func (s *grpcService) RemoveGroupMembers(
+	ctx context.Context,
+	request *rpcv1.GroupMembersRequest,
+) (*rpcv1.GroupMembersResponse, error) {
+	if err := validateGroupMembersRequest(request); err != nil {
+		return nil, statusError(err, "invalid remove group members request")
+	}
+
+	result, err := s.repo.RemoveGroupMembers(ctx, request.GetGroupId(), request.GetUserIds())
+	if err != nil {
+		slog.Error("remove group members failed", "group_id", request.GetGroupId(), "error", err)
+		return nil, statusError(err, "failed to remove group members")
+	}
+
+	return &rpcv1.GroupMembersResponse{
+		GroupId:           request.GetGroupId(),
+		ResolvedUserIds:   result.Resolved,
+		UnresolvedUserIds: result.Unresolved,
+	}, nil
+}
+
+func validateGroupMembersRequest(req *rpcv1.GroupMembersRequest) error {
+	var details []string
+
+	if _, err := uuid.Parse(req.GetGroupId()); err != nil {
+		details = append(details, "group_id must be a valid UUID")
+	}
+
+	if len(req.GetUserIds()) == 0 {
+		details = append(details, "user_ids must not be empty")
+	}
+
+	if len(details) > 0 {
+		return apperrors.NewValidationError("invalid group members request", details...)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+func (s *grpcService) GetPreferences(ctx context.Context, request *rpcv1.GetPreferencesRequest) (*rpcv1.Preferences, error) {
+	if _, err := uuid.Parse(request.GetUserId()); err != nil {
+		err = apperrors.NewValidationError("invalid get preferences request", "user_id must be a valid UUID")
+		return nil, statusError(err, "invalid get preferences request")
+	}
+
+	preferences, err := s.repo.GetPreferences(ctx, request.GetUserId())
+	if err != nil {
+		slog.Error("get preferences failed", "user_id", request.GetUserId(), "error", err)
+		return nil, statusError(err, "failed to get preferences")
+	}
+
+	return preferences, nil
+}
+
+func (s *grpcService) SetPreferences(ctx context.Context, request *rpcv1.SetPreferencesRequest) (*rpcv1.Preferences, error) {
+	var details []string
+
+	if _, err := uuid.Parse(request.GetUserId()); err != nil {
+		details = append(details, "user_id must be a valid UUID")
+	}
+
+	for _, channel := range request.GetDisabledChannels() {
+		if _, ok := rpcv1.Channel_name[int32(channel)]; !ok || channel == rpcv1.Channel_CHANNEL_UNSPECIFIED {
+			details = append(details, "disabled_channels must contain supported channels")
+			break
+		}
+	}
+
+	if !validCategoryNames(request.GetMutedCategories()) {
+		details = append(details, "muted_categories must contain names of 1 to 64 characters")
+	}
+
+	if !validCategoryNames(request.GetSubscribedCategories()) {
+		details = append(details, "subscribed_categories must contain names of 1 to 64 characters")
+	}
+
+	if !validDigestMode(request.GetDigestMode()) {
+		details = append(details, "digest_mode must be a supported digest mode")
+	}
+
+	for _, category := range request.GetSubscribedCategories() {
+		if slices.Contains(request.GetMutedCategories(), category) {
+			details = append(details, "category "+category+" must not be both muted and subscribed")
+			break
+		}
+	}
+
+	if len(details) > 0 {
+		err := apperrors.NewValidationError("invalid set preferences request", details...)
+		return nil, statusError(err, "invalid set preferences request")
+	}
+
+	preferences, err := s.repo.SetPreferences(ctx, &rpcv1.Preferences{
+		UserId:               request.GetUserId(),
+		DisabledChannels:     request.GetDisabledChannels(),
+		MutedCategories:      request.GetMutedCategories(),
+		SubscribedCategories: request.GetSubscribedCategories(),
+		DigestMode:           request.GetDigestMode(),
+	})
+	if err != nil {
+		slog.Error("set preferences failed", "user_id", request.GetUserId(), "error", err)
+		return nil, statusError(err, "failed to set preferences")
+	}
+
+	return preferences, nil
+}
+
+func (s *grpcService) SubscribeCategory(
+	ctx context.Context,
+	request *rpcv1.CategorySubscriptionRequest,
+) (*rpcv1.Preferences, error) {
+	if err := validateCategorySubscriptionRequest(request); err != nil {
+		return nil, statusError(err, "invalid subscribe category request")
+	}
+
+	preferences, err := s.repo.SubscribeCategory(ctx, request.GetUserId(), request.GetCategory())
+	if err != nil {
+		slog.Error("subscribe category failed", "user_id", request.GetUserId(), "category", request.GetCategory(), "error", err)
+		return nil, statusError(err, "failed to subscribe category")
+	}
+
+	return preferences, nil
+}
+
+func (s *grpcService) UnsubscribeCategory(
+	ctx context.Context,
+	request *rpcv1.CategorySubscriptionRequest,
+) (*rpcv1.Preferences, error) {
+	if err := validateCategorySubscriptionRequest(request); err != nil {
+		return nil, statusError(err, "invalid unsubscribe category request")
+	}
+
+	preferences, err := s.repo.UnsubscribeCategory(ctx, request.GetUserId(), request.GetCategory())
+	if err != nil {
+		slog.Error("unsubscribe category failed", "user_id", request.GetUserId(), "category", request.GetCategory(), "error", err)
+		return nil, statusError(err, "failed to unsubscribe category")
+	}
+
+	return preferences, nil
+}
+
+func validateCategorySubscriptionRequest(req *rpcv1.CategorySubscriptionRequest) error {
+	var details []string
+
+	if _, err := uuid.Parse(req.GetUserId()); err != nil {
+		details = append(details, "user_id must be a valid UUID")
+	}
+
+	if !validCategoryNames([]string{req.GetCategory()}) {
+		details = append(details, "category must be 1 to 64 characters long")
+	}
+
+	if len(details) > 0 {
+		return apperrors.NewValidationError("invalid category subscription request", details...)
+	}
+
+	return nil
+}
+
+func validCategoryNames(categories []string) bool {
+	for _, category := range categories {
+		if category == "" || len(category) > maxCategoryLength {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/notification-system-moxicom/persistence-service/internal/quiethours"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+const maxQuietWindows = 8
+
+// validateQuietHours checks a timezone and quiet windows, the field names are
+// those of the request they come from.
+func validateQuietHours(timezoneField, timezone, windowsField string, windows []*rpcv1.QuietWindow) []string {
+	var details []string
+
+	if _, err := quiethours.LoadLocation(timezone); err != nil {
+		details = append(details, fmt.Sprintf("%s: %v", timezoneField, err))
+	}
+
+	if len(windows) > maxQuietWindows {
+		details = append(details, fmt.Sprintf("%s must not contain more than %d windows", windowsField, maxQuietWindows))
+	}
+
+	for i, window := range windows {
+		if err := quiethours.ValidateWindow(quiethours.Window{Start: window.GetStart(), End: window.GetEnd()}); err != nil {
+			details = append(details, fmt.Sprintf("%s[%d]: %v", windowsField, i, err))
+		}
+	}
+
+	return details
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+func (s *grpcService) SetQuota(ctx context.Context, request *rpcv1.SetQuotaRequest) (*rpcv1.Quota, error) {
+	var details []string
+
+	if _, err := uuid.Parse(request.GetSystemId()); err != nil {
+		details = append(details, "system_id must be a valid UUID")
+	}
+
+	if request.GetDailyLimit() < 0 {
+		details = append(details, "daily_limit must not be negative")
+	}
+
+	if request.GetMonthlyLimit() < 0 {
+		details = append(details, "monthly_limit must not be negative")
+	}
+
+	if request.GetSoftLimitPercent() < 0 || request.GetSoftLimitPercent() > 100 {
+		details = append(details, "soft_limit_percent must be between 0 and 100")
+	}
+
+	if len(details) > 0 {
+		return nil, statusError(apperrors.NewValidationError("invalid set quota request", details...), "invalid set quota request")
+	}
+
+	quota, err := s.repo.SetQuota(ctx, &rpcv1.Quota{
+		SystemId:         request.GetSystemId(),
+		DailyLimit:       request.GetDailyLimit(),
+		MonthlyLimit:     request.GetMonthlyLimit(),
+		SoftLimitPercent: request.GetSoftLimitPercent(),
+	})
+	if err != nil {
+		slog.Error("set quota failed", "system_id", request.GetSystemId(), "error", err)
+		return nil, statusError(err, "failed to set quota")
+	}
+
+	return quota, nil
+}
+
+func (s *grpcService) GetUsage(ctx context.Context, request *rpcv1.GetUsageRequest) (*rpcv1.Usage, error) {
+	if _, err := uuid.Parse(request.GetSystemId()); err != nil {
+		err = apperrors.NewValidationError("invalid get usage request", "system_id must be a valid UUID")
+		return nil, statusError(err, "invalid get usage request")
+	}
+
+	usage, err := s.repo.GetUsage(ctx, request.GetSystemId(), time.Now())
+	if err != nil {
+		slog.Error("get usage failed", "system_id", request.GetSystemId(), "error", err)
+		return nil, statusError(err, "failed to get usage")
+	}
+
+	return usage, nil
+}
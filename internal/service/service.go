@@ -2,29 +2,69 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
 	"github.com/notification-system-moxicom/persistence-service/internal/repository"
+	"github.com/notification-system-moxicom/persistence-service/internal/unsubscribe"
+	"github.com/notification-system-moxicom/persistence-service/internal/watch"
 	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
 )
 
+const (
+	defaultNotificationsPageSize = 50
+	maxNotificationsPageSize     = 500
+	maxIdempotencyKeyLength      = 255
+	maxDedupKeyLength            = 255
+	maxCategoryLength            = 64
+)
+
 type Service interface {
 	Ping(ctx context.Context) error
 	rpcv1.PersistenceServiceServer
 }
 
 type grpcService struct {
-	repo repository.Repository
+	repo        repository.Repository
+	hub         *watch.Hub
+	unsubscribe *unsubscribe.Signer
 	rpcv1.UnimplementedPersistenceServiceServer
 }
 
 func (s *grpcService) CreateSystem(ctx context.Context, request *rpcv1.CreateSystemRequest) (*rpcv1.System, error) {
-	system, err := s.repo.CreateSystem(ctx, request.GetName(), request.GetDescription())
+	details := validateQuietHours(
+		"default_timezone", request.GetDefaultTimezone(), "default_quiet_hours", request.GetDefaultQuietHours(),
+	)
+
+	if request.GetDedupWindowSeconds() < 0 {
+		details = append(details, "dedup_window_seconds must not be negative")
+	}
+
+	if len(details) > 0 {
+		err := apperrors.NewValidationError("invalid create system request", details...)
+		return nil, statusError(err, "invalid create system request")
+	}
+
+	dedupWindow := time.Duration(request.GetDedupWindowSeconds()) * time.Second
+
+	system, err := s.repo.CreateSystem(
+		ctx,
+		request.GetName(),
+		request.GetDescription(),
+		dedupWindow,
+		request.GetDefaultTimezone(),
+		request.GetDefaultQuietHours(),
+	)
 	if err != nil {
 		slog.Error("create system failed", "name", request.GetName(), "error", err)
 		return nil, err
@@ -43,7 +83,21 @@ func (s *grpcService) GetSystems(ctx context.Context, request *rpcv1.GetSystemsR
 }
 
 func (s *grpcService) AddUser(ctx context.Context, request *rpcv1.AddUserRequest) (*rpcv1.User, error) {
-	user, err := s.repo.AddUser(ctx, request.GetSystemId(), request.GetIdAtSystem(), request.GetAdapters())
+	if details := validateQuietHours(
+		"timezone", request.GetTimezone(), "quiet_hours", request.GetQuietHours(),
+	); len(details) > 0 {
+		return nil, statusError(apperrors.NewValidationError("invalid add user request", details...), "invalid add user request")
+	}
+
+	user, err := s.repo.AddUser(
+		ctx,
+		request.GetSystemId(),
+		request.GetIdAtSystem(),
+		request.GetAdapters(),
+		request.GetAttributes(),
+		request.GetTimezone(),
+		request.GetQuietHours(),
+	)
 	if err != nil {
 		slog.Error("add user failed", "system_id", request.GetSystemId(), "id_at_system", request.GetIdAtSystem(), "error", err)
 		return nil, err
@@ -73,7 +127,31 @@ func (s *grpcService) UpdateSystem(ctx context.Context, request *rpcv1.UpdateSys
 		description = &d
 	}
 
-	system, err := s.repo.UpdateSystem(ctx, request.GetId(), name, description)
+	details := validateQuietHours(
+		"default_timezone", request.GetDefaultTimezone(), "default_quiet_hours", request.GetDefaultQuietHours(),
+	)
+
+	var dedupWindow *time.Duration
+
+	if request.DedupWindowSeconds != nil {
+		if request.GetDedupWindowSeconds() < 0 {
+			details = append(details, "dedup_window_seconds must not be negative")
+		}
+
+		w := time.Duration(request.GetDedupWindowSeconds()) * time.Second
+		dedupWindow = &w
+	}
+
+	if len(details) > 0 {
+		err := apperrors.NewValidationError("invalid update system request", details...)
+		return nil, statusError(err, "invalid update system request")
+	}
+
+	system, err := s.repo.UpdateSystem(ctx, request.GetId(), name, description, dedupWindow, repository.QuietHoursUpdate{
+		Timezone:        request.DefaultTimezone,
+		QuietHours:      request.GetDefaultQuietHours(),
+		ClearQuietHours: request.GetClearDefaultQuietHours(),
+	})
 	if err != nil {
 		slog.Error("update system failed", "id", request.GetId(), "error", err)
 		return nil, err
@@ -91,13 +169,31 @@ func (s *grpcService) DeleteSystem(ctx context.Context, request *rpcv1.DeleteSys
 }
 
 func (s *grpcService) UpdateUser(ctx context.Context, request *rpcv1.UpdateUserRequest) (*rpcv1.User, error) {
+	if details := validateQuietHours(
+		"timezone", request.GetTimezone(), "quiet_hours", request.GetQuietHours(),
+	); len(details) > 0 {
+		return nil, statusError(apperrors.NewValidationError("invalid update user request", details...), "invalid update user request")
+	}
+
 	var idAtSystem *string
 	if request.GetIdAtSystem() != "" {
 		id := request.GetIdAtSystem()
 		idAtSystem = &id
 	}
 
-	user, err := s.repo.UpdateUser(ctx, request.GetId(), idAtSystem, request.GetAdapters())
+	user, err := s.repo.UpdateUser(
+		ctx,
+		request.GetId(),
+		idAtSystem,
+		request.GetAdapters(),
+		request.GetAttributes(),
+		request.GetRemovedAttributes(),
+		repository.QuietHoursUpdate{
+			Timezone:        request.Timezone,
+			QuietHours:      request.GetQuietHours(),
+			ClearQuietHours: request.GetClearQuietHours(),
+		},
+	)
 	if err != nil {
 		slog.Error("update user failed", "id", request.GetId(), "error", err)
 		return nil, err
@@ -117,21 +213,72 @@ func (s *grpcService) DeleteUser(ctx context.Context, request *rpcv1.DeleteUserR
 func (s *grpcService) Notify(ctx context.Context, request *rpcv1.NotifyRequest) (*rpcv1.NotifyResponse, error) {
 	if err := validateNotifyRequest(request); err != nil {
 		slog.Info("invalid notify request", "req", request)
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, statusError(err, "invalid notify request")
 	}
 
-	notificationID, err := s.repo.CreateNotification(
-		ctx,
-		request.GetSystemId(),
-		request.GetUserIds(),
-		request.GetContent(),
-	)
+	params := repository.CreateNotificationParams{
+		SystemID:       request.GetSystemId(),
+		UserIDs:        request.GetUserIds(),
+		GroupIDs:       request.GetGroupIds(),
+		Content:        request.GetContent(),
+		AllUsers:       request.GetAudience() == rpcv1.Audience_AUDIENCE_ALL_USERS,
+		IdempotencyKey: request.GetIdempotencyKey(),
+
+		AttributeFilter:  request.GetAttributeFilter(),
+		StrictRecipients: request.GetStrictRecipients(),
+
+		DedupWindow: time.Duration(request.GetDedupWindowSeconds()) * time.Second,
+		DedupKey:    request.GetDedupKey(),
+
+		Urgent:      request.GetPriority() == rpcv1.Priority_PRIORITY_URGENT,
+		Category:    request.GetCategory(),
+		LowPriority: request.GetPriority() == rpcv1.Priority_PRIORITY_LOW,
+	}
+
+	if structured := request.GetStructuredContent(); structured != nil {
+		params.Content = structured.GetText()
+		params.StructuredContent = structured
+	}
+
+	if request.GetTemplateId() != "" {
+		content, err := s.renderTemplate(ctx, request.GetSystemId(), request.GetTemplateId(), request.GetVariables())
+		if err != nil {
+			slog.Info("render notification template failed", "template_id", request.GetTemplateId(), "error", err)
+			return nil, statusError(err, "failed to render template")
+		}
+
+		params.Content = content
+	}
+
+	if request.GetSendAt() > 0 {
+		params.SendAt = time.Unix(request.GetSendAt(), 0).UTC()
+	}
+
+	if params.IdempotencyKey != "" {
+		requestHash, err := notifyRequestHash(request)
+		if err != nil {
+			slog.Error("hash notify request failed", "system_id", request.GetSystemId(), "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to hash notify request: %v", err)
+		}
+
+		params.RequestHash = requestHash
+	}
+
+	result, err := s.repo.CreateNotification(ctx, params)
 	if err != nil {
 		slog.Error("create notification failed", "system_id", request.GetSystemId(), "user_ids", request.GetUserIds(), "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to create notification: %v", err)
+		return nil, statusError(err, "failed to create notification")
 	}
 
-	return &rpcv1.NotifyResponse{NotificationId: notificationID}, nil
+	return &rpcv1.NotifyResponse{
+		NotificationId:    result.NotificationID,
+		ResolvedUserIds:   result.Resolved,
+		UnresolvedUserIds: result.Unresolved,
+		RecipientCount:    result.RecipientCount,
+		DeduplicatedCount: result.DeduplicatedCount,
+		SuppressedCount:   result.SuppressedCount,
+		DigestedCount:     result.DigestedCount,
+	}, nil
 }
 
 func validateNotifyRequest(req *rpcv1.NotifyRequest) error {
@@ -143,12 +290,60 @@ func validateNotifyRequest(req *rpcv1.NotifyRequest) error {
 		details = append(details, "system_id must be a valid UUID")
 	}
 
-	if len(req.GetUserIds()) == 0 {
-		details = append(details, "user_ids must not be empty")
+	switch req.GetAudience() {
+	case rpcv1.Audience_AUDIENCE_UNSPECIFIED:
+		if len(req.GetUserIds()) == 0 && len(req.GetGroupIds()) == 0 {
+			details = append(details, "user_ids or group_ids must not be empty")
+		}
+
+		for _, groupID := range req.GetGroupIds() {
+			if _, err := uuid.Parse(groupID); err != nil {
+				details = append(details, "group_ids must contain valid UUIDs")
+				break
+			}
+		}
+	case rpcv1.Audience_AUDIENCE_ALL_USERS:
+		if len(req.GetUserIds()) > 0 || len(req.GetGroupIds()) > 0 {
+			details = append(details, "user_ids and group_ids must be empty when audience is ALL_USERS")
+		}
+
+		if req.GetStrictRecipients() {
+			details = append(details, "strict_recipients is not supported when audience is ALL_USERS")
+		}
+
+		details = append(details, validateAttributeFilter(req.GetAttributeFilter())...)
+	default:
+		details = append(details, "audience is not supported")
+	}
+
+	if req.GetAudience() != rpcv1.Audience_AUDIENCE_ALL_USERS && req.GetAttributeFilter() != nil {
+		details = append(details, "attribute_filter requires audience ALL_USERS")
+	}
+
+	details = append(details, validateNotifyContent(req)...)
+
+	if req.GetSendAt() < 0 {
+		details = append(details, "send_at must be a unix timestamp")
+	}
+
+	if _, ok := rpcv1.Priority_name[int32(req.GetPriority())]; !ok {
+		details = append(details, "priority is not supported")
 	}
 
-	if req.GetContent() == "" {
-		details = append(details, "content is required")
+	if len(req.GetCategory()) > maxCategoryLength {
+		details = append(details, "category must not be longer than 64 characters")
+	}
+
+	if len(req.GetIdempotencyKey()) > maxIdempotencyKeyLength {
+		details = append(details, "idempotency_key must not be longer than 255 characters")
+	}
+
+	if req.GetDedupWindowSeconds() < 0 {
+		details = append(details, "dedup_window_seconds must not be negative")
+	}
+
+	if len(req.GetDedupKey()) > maxDedupKeyLength {
+		details = append(details, "dedup_key must not be longer than 255 characters")
 	}
 
 	if len(details) > 0 {
@@ -158,12 +353,307 @@ func validateNotifyRequest(req *rpcv1.NotifyRequest) error {
 	return nil
 }
 
+func validateAttributeFilter(filter *rpcv1.AttributeFilter) []string {
+	var details []string
+
+	for i, condition := range filter.GetConditions() {
+		field := fmt.Sprintf("attribute_filter.conditions[%d]", i)
+
+		if condition.GetKey() == "" {
+			details = append(details, field+".key is required")
+		}
+
+		values := len(condition.GetValues())
+
+		switch condition.GetOperator() {
+		case rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_EQUALS, rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_NOT_EQUALS:
+			if values != 1 {
+				details = append(details, field+" must have exactly one value")
+			}
+		case rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_IN, rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_NOT_IN:
+			if values == 0 {
+				details = append(details, field+" must have at least one value")
+			}
+		case rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_EXISTS, rpcv1.AttributeOperator_ATTRIBUTE_OPERATOR_NOT_EXISTS:
+			if values != 0 {
+				details = append(details, field+" must not have values")
+			}
+		default:
+			details = append(details, field+".operator is required")
+		}
+	}
+
+	return details
+}
+
+// notifyRequestHash fingerprints everything in the request except the
+// idempotency key itself, so retries can be told apart from key reuse.
+func notifyRequestHash(req *rpcv1.NotifyRequest) (string, error) {
+	clone, ok := proto.Clone(req).(*rpcv1.NotifyRequest)
+	if !ok {
+		return "", errors.New("unexpected notify request type")
+	}
+
+	clone.IdempotencyKey = ""
+
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(clone)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(payload)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (s *grpcService) GetNotification(
+	ctx context.Context,
+	request *rpcv1.GetNotificationRequest,
+) (*rpcv1.Notification, error) {
+	if _, err := uuid.Parse(request.GetId()); err != nil {
+		return nil, statusError(
+			apperrors.NewValidationError("invalid get notification request", "id must be a valid UUID"),
+			"invalid get notification request",
+		)
+	}
+
+	notification, err := s.repo.GetNotification(ctx, request.GetId())
+	if err != nil {
+		slog.Error("get notification failed", "id", request.GetId(), "error", err)
+		return nil, statusError(err, "failed to get notification")
+	}
+
+	return notification, nil
+}
+
+func (s *grpcService) CancelNotification(
+	ctx context.Context,
+	request *rpcv1.CancelNotificationRequest,
+) (*rpcv1.Notification, error) {
+	if _, err := uuid.Parse(request.GetId()); err != nil {
+		return nil, statusError(
+			apperrors.NewValidationError("invalid cancel notification request", "id must be a valid UUID"),
+			"invalid cancel notification request",
+		)
+	}
+
+	notification, err := s.repo.CancelNotification(ctx, request.GetId())
+	if err != nil {
+		slog.Error("cancel notification failed", "id", request.GetId(), "error", err)
+		return nil, statusError(err, "failed to cancel notification")
+	}
+
+	return notification, nil
+}
+
+func (s *grpcService) ListNotifications(
+	ctx context.Context,
+	request *rpcv1.ListNotificationsRequest,
+) (*rpcv1.Notifications, error) {
+	if err := validateListNotificationsRequest(request); err != nil {
+		slog.Info("invalid list notifications request", "req", request)
+		return nil, statusError(err, "invalid list notifications request")
+	}
+
+	filter := repository.NotificationFilter{
+		SystemID:            request.GetSystemId(),
+		Status:              request.GetStatus(),
+		RecipientIDAtSystem: request.GetRecipientIdAtSystem(),
+		Limit:               int(request.GetPageSize()),
+		Cursor:              request.GetPageToken(),
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultNotificationsPageSize
+	}
+
+	if request.GetCreatedFrom() != 0 {
+		filter.CreatedFrom = time.Unix(request.GetCreatedFrom(), 0).UTC()
+	}
+
+	if request.GetCreatedTo() != 0 {
+		filter.CreatedTo = time.Unix(request.GetCreatedTo(), 0).UTC()
+	}
+
+	notifications, nextPageToken, err := s.repo.ListNotifications(ctx, filter)
+	if err != nil {
+		slog.Error("list notifications failed", "system_id", request.GetSystemId(), "error", err)
+		return nil, statusError(err, "failed to list notifications")
+	}
+
+	return &rpcv1.Notifications{
+		Notifications: notifications,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func validateListNotificationsRequest(req *rpcv1.ListNotificationsRequest) error {
+	var details []string
+
+	if req.GetSystemId() != "" {
+		if _, err := uuid.Parse(req.GetSystemId()); err != nil {
+			details = append(details, "system_id must be a valid UUID")
+		}
+	}
+
+	if req.GetPageSize() < 0 || req.GetPageSize() > maxNotificationsPageSize {
+		details = append(details, "page_size must be between 0 and 500")
+	}
+
+	if req.GetCreatedFrom() != 0 && req.GetCreatedTo() != 0 && req.GetCreatedFrom() >= req.GetCreatedTo() {
+		details = append(details, "created_from must be before created_to")
+	}
+
+	if len(details) > 0 {
+		return apperrors.NewValidationError("invalid list notifications request", details...)
+	}
+
+	return nil
+}
+
+func (s *grpcService) UpdateRecipientStatus(
+	ctx context.Context,
+	request *rpcv1.UpdateRecipientStatusRequest,
+) (*rpcv1.RecipientDelivery, error) {
+	if err := validateUpdateRecipientStatusRequest(request); err != nil {
+		slog.Info("invalid update recipient status request", "req", request)
+		return nil, statusError(err, "invalid update recipient status request")
+	}
+
+	delivery, err := s.repo.UpdateRecipientStatus(
+		ctx,
+		request.GetNotificationId(),
+		time.Time{},
+		request.GetUserId(),
+		request.GetChannel(),
+		request.GetStatus(),
+		request.GetError(),
+	)
+	if err != nil {
+		slog.Error(
+			"update recipient status failed",
+			"notification_id", request.GetNotificationId(),
+			"user_id", request.GetUserId(),
+			"error", err,
+		)
+
+		return nil, statusError(err, "failed to update recipient status")
+	}
+
+	return delivery, nil
+}
+
+// isReportableDeliveryStatus reports whether dispatchers may set the status.
+// The other statuses are set by the service itself: cancellation,
+// deduplication, preference suppression and digests.
+func isReportableDeliveryStatus(status rpcv1.DeliveryStatus) bool {
+	switch status {
+	case rpcv1.DeliveryStatus_DELIVERY_STATUS_QUEUED,
+		rpcv1.DeliveryStatus_DELIVERY_STATUS_SENDING,
+		rpcv1.DeliveryStatus_DELIVERY_STATUS_DELIVERED,
+		rpcv1.DeliveryStatus_DELIVERY_STATUS_FAILED,
+		rpcv1.DeliveryStatus_DELIVERY_STATUS_BOUNCED:
+		return true
+	default:
+		return false
+	}
+}
+
+func validateUpdateRecipientStatusRequest(req *rpcv1.UpdateRecipientStatusRequest) error {
+	var details []string
+
+	if _, err := uuid.Parse(req.GetNotificationId()); err != nil {
+		details = append(details, "notification_id must be a valid UUID")
+	}
+
+	if _, err := uuid.Parse(req.GetUserId()); err != nil {
+		details = append(details, "user_id must be a valid UUID")
+	}
+
+	if req.GetChannel() == rpcv1.Channel_CHANNEL_UNSPECIFIED {
+		details = append(details, "channel is required")
+	}
+
+	if !isReportableDeliveryStatus(req.GetStatus()) {
+		details = append(details, "status must be one of QUEUED, SENDING, DELIVERED, FAILED, BOUNCED")
+	}
+
+	if len(details) > 0 {
+		return apperrors.NewValidationError("invalid update recipient status request", details...)
+	}
+
+	return nil
+}
+
+// notifyContentSource is implemented by the requests that carry notification
+// content: NotifyRequest and NotificationSpec.
+type notifyContentSource interface {
+	GetContent() string
+	GetTemplateId() string
+	GetStructuredContent() *rpcv1.NotificationContent
+}
+
+// validateNotifyContent checks that exactly one content source is given:
+// content, template_id or structured_content.
+func validateNotifyContent(req notifyContentSource) []string {
+	sources := 0
+
+	for _, set := range []bool{
+		req.GetContent() != "",
+		req.GetTemplateId() != "",
+		req.GetStructuredContent() != nil,
+	} {
+		if set {
+			sources++
+		}
+	}
+
+	switch {
+	case sources == 0:
+		return []string{"one of content, template_id or structured_content is required"}
+	case sources > 1:
+		return []string{"content, template_id and structured_content are mutually exclusive"}
+	case req.GetTemplateId() != "":
+		if _, err := uuid.Parse(req.GetTemplateId()); err != nil {
+			return []string{"template_id must be a valid UUID"}
+		}
+	case req.GetStructuredContent() != nil:
+		return validateStructuredContent(req.GetStructuredContent())
+	}
+
+	return nil
+}
+
+func validateStructuredContent(content *rpcv1.NotificationContent) []string {
+	var details []string
+
+	if content.GetText() == "" {
+		details = append(details, "structured_content.text is required")
+	}
+
+	if email := content.GetEmail(); email != nil && email.GetSubject() == "" {
+		details = append(details, "structured_content.email.subject is required")
+	}
+
+	if sms := content.GetSms(); sms != nil && sms.GetText() == "" {
+		details = append(details, "structured_content.sms.text is required")
+	}
+
+	if telegram := content.GetTelegram(); telegram != nil && telegram.GetMarkdown() == "" {
+		details = append(details, "structured_content.telegram.markdown is required")
+	}
+
+	return details
+}
+
 func (s *grpcService) Ping(ctx context.Context) error {
 	return nil
 }
 
-func NewService(repo repository.Repository) Service {
+func NewService(repo repository.Repository, hub *watch.Hub, signer *unsubscribe.Signer) Service {
 	return &grpcService{
-		repo: repo,
+		repo:        repo,
+		hub:         hub,
+		unsubscribe: signer,
 	}
 }
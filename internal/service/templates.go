@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	"github.com/notification-system-moxicom/persistence-service/internal/templating"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+func (s *grpcService) CreateTemplate(ctx context.Context, request *rpcv1.CreateTemplateRequest) (*rpcv1.Template, error) {
+	var details []string
+
+	if _, err := uuid.Parse(request.GetSystemId()); err != nil {
+		details = append(details, "system_id must be a valid UUID")
+	}
+
+	if request.GetName() == "" {
+		details = append(details, "name is required")
+	}
+
+	if request.GetBody() == "" {
+		details = append(details, "body is required")
+	} else if err := templating.Validate(request.GetBody()); err != nil {
+		return nil, statusError(err, "invalid template")
+	}
+
+	if len(details) > 0 {
+		return nil, statusError(apperrors.NewValidationError("invalid create template request", details...), "invalid create template request")
+	}
+
+	template, err := s.repo.CreateTemplate(ctx, request.GetSystemId(), request.GetName(), request.GetBody())
+	if err != nil {
+		slog.Error("create template failed", "system_id", request.GetSystemId(), "name", request.GetName(), "error", err)
+		return nil, statusError(err, "failed to create template")
+	}
+
+	return template, nil
+}
+
+func (s *grpcService) GetTemplate(ctx context.Context, request *rpcv1.GetTemplateRequest) (*rpcv1.Template, error) {
+	if _, err := uuid.Parse(request.GetId()); err != nil {
+		return nil, statusError(apperrors.NewValidationError("invalid get template request", "id must be a valid UUID"), "invalid get template request")
+	}
+
+	template, err := s.repo.GetTemplate(ctx, request.GetId())
+	if err != nil {
+		slog.Error("get template failed", "id", request.GetId(), "error", err)
+		return nil, statusError(err, "failed to get template")
+	}
+
+	return template, nil
+}
+
+func (s *grpcService) ListTemplates(ctx context.Context, request *rpcv1.ListTemplatesRequest) (*rpcv1.Templates, error) {
+	if _, err := uuid.Parse(request.GetSystemId()); err != nil {
+		return nil, statusError(apperrors.NewValidationError("invalid list templates request", "system_id must be a valid UUID"), "invalid list templates request")
+	}
+
+	templates, err := s.repo.ListTemplates(ctx, request.GetSystemId())
+	if err != nil {
+		slog.Error("list templates failed", "system_id", request.GetSystemId(), "error", err)
+		return nil, statusError(err, "failed to list templates")
+	}
+
+	return &rpcv1.Templates{Templates: templates}, nil
+}
+
+func (s *grpcService) UpdateTemplate(ctx context.Context, request *rpcv1.UpdateTemplateRequest) (*rpcv1.Template, error) {
+	if _, err := uuid.Parse(request.GetId()); err != nil {
+		return nil, statusError(apperrors.NewValidationError("invalid update template request", "id must be a valid UUID"), "invalid update template request")
+	}
+
+	var name, body *string
+	if request.GetName() != "" {
+		n := request.GetName()
+		name = &n
+	}
+
+	if request.GetBody() != "" {
+		if err := templating.Validate(request.GetBody()); err != nil {
+			return nil, statusError(err, "invalid template")
+		}
+
+		b := request.GetBody()
+		body = &b
+	}
+
+	template, err := s.repo.UpdateTemplate(ctx, request.GetId(), name, body)
+	if err != nil {
+		slog.Error("update template failed", "id", request.GetId(), "error", err)
+		return nil, statusError(err, "failed to update template")
+	}
+
+	return template, nil
+}
+
+func (s *grpcService) DeleteTemplate(ctx context.Context, request *rpcv1.DeleteTemplateRequest) (*rpcv1.InfoMessage, error) {
+	if _, err := uuid.Parse(request.GetId()); err != nil {
+		return nil, statusError(apperrors.NewValidationError("invalid delete template request", "id must be a valid UUID"), "invalid delete template request")
+	}
+
+	if err := s.repo.DeleteTemplate(ctx, request.GetId()); err != nil {
+		slog.Error("delete template failed", "id", request.GetId(), "error", err)
+		return nil, statusError(err, "failed to delete template")
+	}
+
+	return &rpcv1.InfoMessage{Message: "template deleted"}, nil
+}
+
+// renderTemplate renders the template of the given system with vars.
+func (s *grpcService) renderTemplate(
+	ctx context.Context,
+	systemID,
+	templateID string,
+	vars map[string]string,
+) (string, error) {
+	template, err := s.systemTemplate(ctx, systemID, templateID)
+	if err != nil {
+		return "", err
+	}
+
+	return templating.Render(template.GetBody(), vars)
+}
+
+// systemTemplate loads the template of the given system. A template that
+// belongs to another system is reported as missing.
+func (s *grpcService) systemTemplate(ctx context.Context, systemID, templateID string) (*rpcv1.Template, error) {
+	template, err := s.repo.GetTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if template.GetSystemId() != systemID {
+		return nil, apperrors.NewNotFoundError("template not found", "template "+templateID+" does not exist")
+	}
+
+	return template, nil
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	"github.com/notification-system-moxicom/persistence-service/internal/unsubscribe"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+func (s *grpcService) CreateUnsubscribeToken(
+	ctx context.Context,
+	request *rpcv1.CreateUnsubscribeTokenRequest,
+) (*rpcv1.UnsubscribeToken, error) {
+	if err := validateCreateUnsubscribeTokenRequest(request, s.unsubscribe.TTL()); err != nil {
+		return nil, statusError(err, "invalid create unsubscribe token request")
+	}
+
+	systemID, err := s.repo.GetUserSystemID(ctx, request.GetUserId())
+	if err != nil {
+		slog.Error("get user system failed", "user_id", request.GetUserId(), "error", err)
+		return nil, statusError(err, "failed to create unsubscribe token")
+	}
+
+	if systemID != request.GetSystemId() {
+		err = apperrors.NewNotFoundError(
+			"user not found", "user "+request.GetUserId()+" does not exist in system "+request.GetSystemId(),
+		)
+
+		return nil, statusError(err, "failed to create unsubscribe token")
+	}
+
+	ttl := s.unsubscribe.TTL()
+	if request.GetTtlSeconds() > 0 {
+		ttl = time.Duration(request.GetTtlSeconds()) * time.Second
+	}
+
+	expiresAt := time.Now().Add(ttl).Unix()
+
+	token, err := s.unsubscribe.Sign(unsubscribe.Claims{
+		SystemID:  request.GetSystemId(),
+		UserID:    request.GetUserId(),
+		Channel:   request.GetChannel(),
+		Category:  request.GetCategory(),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		slog.Error("sign unsubscribe token failed", "user_id", request.GetUserId(), "error", err)
+		return nil, statusError(err, "failed to create unsubscribe token")
+	}
+
+	return &rpcv1.UnsubscribeToken{Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// validateCreateUnsubscribeTokenRequest checks the request. A requested ttl may
+// shorten tokens but never extend them past the configured maxTTL.
+func validateCreateUnsubscribeTokenRequest(req *rpcv1.CreateUnsubscribeTokenRequest, maxTTL time.Duration) error {
+	var details []string
+
+	if _, err := uuid.Parse(req.GetSystemId()); err != nil {
+		details = append(details, "system_id must be a valid UUID")
+	}
+
+	if _, err := uuid.Parse(req.GetUserId()); err != nil {
+		details = append(details, "user_id must be a valid UUID")
+	}
+
+	if _, ok := rpcv1.Channel_name[int32(req.GetChannel())]; !ok || req.GetChannel() == rpcv1.Channel_CHANNEL_UNSPECIFIED {
+		details = append(details, "channel is not supported")
+	}
+
+	if len(req.GetCategory()) > maxCategoryLength {
+		details = append(details, "category must not be longer than 64 characters")
+	}
+
+	if req.GetTtlSeconds() < 0 {
+		details = append(details, "ttl_seconds must not be negative")
+	}
+
+	if maxSeconds := int64(maxTTL / time.Second); req.GetTtlSeconds() > maxSeconds {
+		details = append(details, fmt.Sprintf("ttl_seconds must not be greater than %d", maxSeconds))
+	}
+
+	if len(details) > 0 {
+		return apperrors.NewValidationError("invalid create unsubscribe token request", details...)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+	"github.com/notification-system-moxicom/persistence-service/internal/repository"
+	"github.com/notification-system-moxicom/persistence-service/internal/watch"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+const watchReplayBatchSize = 500
+
+// WatchNotifications replays the stored events after the request cursor and
+// then streams new ones as they are heard. When the hub drops the subscription
+// because the stream fell behind, the missed events are replayed from the
+// table before following live events again.
+func (s *grpcService) WatchNotifications(
+	request *rpcv1.WatchNotificationsRequest,
+	stream rpcv1.PersistenceService_WatchNotificationsServer,
+) error {
+	if err := validateWatchNotificationsRequest(request); err != nil {
+		return statusError(err, "invalid watch notifications request")
+	}
+
+	ctx := stream.Context()
+
+	if request.GetNotificationId() != "" {
+		if _, err := s.repo.GetNotification(ctx, request.GetNotificationId()); err != nil {
+			return statusError(err, "failed to get notification")
+		}
+	}
+
+	filter := repository.NotificationEventFilter{
+		NotificationID: request.GetNotificationId(),
+		SystemID:       request.GetSystemId(),
+	}
+	cursor := request.GetAfterEventId()
+
+	for {
+		// Subscribe before replaying, so nothing committed in between is lost.
+		// Events seen in both are skipped by cursor.
+		sub := s.hub.Subscribe(filter)
+
+		var err error
+
+		cursor, err = s.replayNotificationEvents(stream, filter, cursor)
+		if err == nil {
+			cursor, err = followNotificationEvents(stream, sub, cursor)
+		}
+
+		sub.Close()
+
+		if err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+
+		slog.Debug("notification event subscription dropped, catching up", slog.Int64("cursor", cursor))
+	}
+}
+
+// replayNotificationEvents sends the stored events after cursor and returns
+// the new cursor.
+func (s *grpcService) replayNotificationEvents(
+	stream rpcv1.PersistenceService_WatchNotificationsServer,
+	filter repository.NotificationEventFilter,
+	cursor int64,
+) (int64, error) {
+	for {
+		events, err := s.repo.ListNotificationEvents(stream.Context(), filter, cursor, watchReplayBatchSize)
+		if err != nil {
+			slog.Error("list notification events failed", "cursor", cursor, "error", err)
+			return cursor, statusError(err, "failed to list notification events")
+		}
+
+		for _, event := range events {
+			if err = stream.Send(event); err != nil {
+				return cursor, err
+			}
+
+			cursor = event.GetEventId()
+		}
+
+		if len(events) < watchReplayBatchSize {
+			return cursor, nil
+		}
+	}
+}
+
+// followNotificationEvents sends live events newer than cursor until the
+// subscription is dropped or the stream ends, and returns the new cursor.
+func followNotificationEvents(
+	stream rpcv1.PersistenceService_WatchNotificationsServer,
+	sub *watch.Subscription,
+	cursor int64,
+) (int64, error) {
+	for {
+		select {
+		case <-stream.Context().Done():
+			return cursor, nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return cursor, nil
+			}
+
+			if event.GetEventId() <= cursor {
+				continue
+			}
+
+			if err := stream.Send(event); err != nil {
+				return cursor, err
+			}
+
+			cursor = event.GetEventId()
+		}
+	}
+}
+
+func validateWatchNotificationsRequest(req *rpcv1.WatchNotificationsRequest) error {
+	var details []string
+
+	switch {
+	case req.GetNotificationId() == "" && req.GetSystemId() == "":
+		details = append(details, "one of notification_id and system_id is required")
+	case req.GetNotificationId() != "" && req.GetSystemId() != "":
+		details = append(details, "notification_id and system_id are mutually exclusive")
+	case req.GetNotificationId() != "":
+		if _, err := uuid.Parse(req.GetNotificationId()); err != nil {
+			details = append(details, "notification_id must be a valid UUID")
+		}
+	default:
+		if _, err := uuid.Parse(req.GetSystemId()); err != nil {
+			details = append(details, "system_id must be a valid UUID")
+		}
+	}
+
+	if req.GetAfterEventId() < 0 {
+		details = append(details, "after_event_id must not be negative")
+	}
+
+	if len(details) > 0 {
+		return apperrors.NewValidationError("invalid watch notifications request", details...)
+	}
+
+	return nil
+}
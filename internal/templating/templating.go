@@ -0,0 +1,108 @@
+package templating
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/notification-system-moxicom/persistence-service/internal/errors"
+)
+
+const (
+	openDelim  = "{{"
+	closeDelim = "}}"
+)
+
+var variableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.\-]*$`) //nolint:gochecknoglobals // compiled once
+
+type segment struct {
+	text     string
+	variable string
+}
+
+// Validate checks that body is a well-formed template.
+func Validate(body string) error {
+	_, err := parse(body)
+
+	return err
+}
+
+// Render substitutes every {{variable}} placeholder in body with its value from
+// vars. Rendering is strict: if any placeholder has no value, a ValidationError
+// listing all missing variables is returned.
+func Render(body string, vars map[string]string) (string, error) {
+	segments, err := parse(body)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		out     strings.Builder
+		missing []string
+		seen    = make(map[string]struct{})
+	)
+
+	for _, seg := range segments {
+		if seg.variable == "" {
+			out.WriteString(seg.text)
+			continue
+		}
+
+		value, ok := vars[seg.variable]
+		if !ok {
+			if _, reported := seen[seg.variable]; !reported {
+				seen[seg.variable] = struct{}{}
+				missing = append(missing, "missing variable: "+seg.variable)
+			}
+
+			continue
+		}
+
+		out.WriteString(value)
+	}
+
+	if len(missing) > 0 {
+		return "", errors.NewValidationError("template variables are missing", missing...)
+	}
+
+	return out.String(), nil
+}
+
+func parse(body string) ([]segment, error) {
+	var (
+		segments []segment
+		rest     = body
+	)
+
+	for {
+		start := strings.Index(rest, openDelim)
+		if start < 0 {
+			if strings.Contains(rest, closeDelim) {
+				return nil, errors.NewValidationError("invalid template", "unexpected "+closeDelim+" without opening "+openDelim)
+			}
+
+			segments = append(segments, segment{text: rest})
+
+			return segments, nil
+		}
+
+		if strings.Contains(rest[:start], closeDelim) {
+			return nil, errors.NewValidationError("invalid template", "unexpected "+closeDelim+" without opening "+openDelim)
+		}
+
+		segments = append(segments, segment{text: rest[:start]})
+		rest = rest[start+len(openDelim):]
+
+		end := strings.Index(rest, closeDelim)
+		if end < 0 {
+			return nil, errors.NewValidationError("invalid template", "unclosed "+openDelim)
+		}
+
+		name := strings.TrimSpace(rest[:end])
+		if !variableName.MatchString(name) {
+			return nil, errors.NewValidationError("invalid template", "invalid variable name: "+rest[:end])
+		}
+
+		segments = append(segments, segment{variable: name})
+		rest = rest[end+len(closeDelim):]
+	}
+}
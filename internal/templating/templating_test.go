@@ -0,0 +1,129 @@
+package templating
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	apperrors "github.com/notification-system-moxicom/persistence-service/internal/errors"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		body        string
+		vars        map[string]string
+		want        string
+		wantDetails []string // set when a ValidationError is expected
+	}{
+		{
+			name: "no placeholders",
+			body: "Hello world",
+			want: "Hello world",
+		},
+		{
+			name: "empty body",
+			body: "",
+			want: "",
+		},
+		{
+			name: "placeholders",
+			body: "Hello {{name}}, your order {{order.id}} has shipped",
+			vars: map[string]string{"name": "Ada", "order.id": "42"},
+			want: "Hello Ada, your order 42 has shipped",
+		},
+		{
+			name: "whitespace inside the delimiters",
+			body: "Hi {{ name }}!",
+			vars: map[string]string{"name": "Ada"},
+			want: "Hi Ada!",
+		},
+		{
+			name: "repeated and adjacent placeholders",
+			body: "{{a}}{{b}}{{a}}",
+			vars: map[string]string{"a": "1", "b": "2"},
+			want: "121",
+		},
+		{
+			name: "values are not rendered again",
+			body: "{{a}}",
+			vars: map[string]string{"a": "{{b}}", "b": "nope"},
+			want: "{{b}}",
+		},
+		{
+			name: "empty value",
+			body: "[{{a}}]",
+			vars: map[string]string{"a": ""},
+			want: "[]",
+		},
+		{
+			name: "unused variables are ignored",
+			body: "{{a}}",
+			vars: map[string]string{"a": "1", "b": "2"},
+			want: "1",
+		},
+		{
+			name:        "missing variables are reported once each",
+			body:        "{{a}} {{b}} {{a}} {{c}}",
+			vars:        map[string]string{"c": "3"},
+			wantDetails: []string{"missing variable: a", "missing variable: b"},
+		},
+		{
+			name:        "unclosed placeholder",
+			body:        "Hello {{name",
+			wantDetails: []string{"unclosed {{"},
+		},
+		{
+			name:        "closing delimiter without opening",
+			body:        "Hello name}}",
+			wantDetails: []string{"unexpected }} without opening {{"},
+		},
+		{
+			name:        "closing delimiter before a placeholder",
+			body:        "a}} {{b}}",
+			vars:        map[string]string{"b": "2"},
+			wantDetails: []string{"unexpected }} without opening {{"},
+		},
+		{
+			name:        "invalid variable name",
+			body:        "{{1st}}",
+			wantDetails: []string{"invalid variable name: 1st"},
+		},
+		{
+			name:        "empty placeholder",
+			body:        "{{ }}",
+			wantDetails: []string{"invalid variable name:  "},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Render(tt.body, tt.vars)
+
+			if tt.wantDetails == nil {
+				if err != nil {
+					t.Fatalf("Render() error = %v", err)
+				}
+
+				if got != tt.want {
+					t.Errorf("Render() = %q, want %q", got, tt.want)
+				}
+
+				return
+			}
+
+			var validationErr apperrors.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Render() error = %v, want a ValidationError", err)
+			}
+
+			if !slices.Equal(validationErr.Details(), tt.wantDetails) {
+				t.Errorf("Render() details = %q, want %q", validationErr.Details(), tt.wantDetails)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Package unsubscribe signs and verifies the tokens of one-click unsubscribe
+// links. A token names the recipient and what to unsubscribe from, expires,
+// and carries the id of the key it is signed with so keys can be rotated.
+package unsubscribe
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+const (
+	defaultTTL = 30 * 24 * time.Hour
+	// minSecretLength is the shortest accepted signing secret, the output
+	// size of HMAC-SHA256.
+	minSecretLength = 32
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid unsubscribe token")
+	ErrExpiredToken = errors.New("unsubscribe token has expired")
+)
+
+type Config struct {
+	// Keys maps key ids to signing keys. Tokens are verified with the key they
+	// name, so a rotated out key should stay until its tokens have expired.
+	Keys map[string]Key `yaml:"keys"`
+	// SigningKey is the id of the key new tokens are signed with.
+	SigningKey string `yaml:"signing_key"`
+	// TTL is the lifetime of tokens minted without one. Defaults to 30 days.
+	TTL time.Duration `yaml:"ttl"`
+}
+
+// Key is a signing secret, read from the SecretEnv environment variable.
+// Secrets are never taken from the config file: anyone who knows a secret can
+// forge tokens and unsubscribe any user.
+type Key struct {
+	// Secret is rejected when set. It is kept so that a secret left in the
+	// config file fails startup instead of being silently ignored.
+	Secret    string `yaml:"secret"`
+	SecretEnv string `yaml:"secret_env"`
+}
+
+func (k Key) secret() (string, error) {
+	if k.Secret != "" {
+		return "", errors.New("secret must not be set in the config, use secret_env")
+	}
+
+	if k.SecretEnv == "" {
+		return "", errors.New("secret_env is not set")
+	}
+
+	secret := os.Getenv(k.SecretEnv)
+	if len(secret) < minSecretLength {
+		return "", fmt.Errorf("environment variable %s must hold a secret of at least %d bytes",
+			k.SecretEnv, minSecretLength)
+	}
+
+	return secret, nil
+}
+
+// Claims are what a token unsubscribes from: the category when it is set,
+// otherwise the channel.
+type Claims struct {
+	SystemID  string        `json:"sys"`
+	UserID    string        `json:"usr"`
+	Channel   rpcv1.Channel `json:"ch"`
+	Category  string        `json:"cat,omitempty"`
+	ExpiresAt int64         `json:"exp"`
+}
+
+type Signer struct {
+	keys       map[string][]byte
+	signingKey string
+	ttl        time.Duration
+}
+
+func New(cfg Config) (*Signer, error) {
+	signer := &Signer{
+		keys:       make(map[string][]byte, len(cfg.Keys)),
+		signingKey: cfg.SigningKey,
+		ttl:        cfg.TTL,
+	}
+
+	if signer.ttl <= 0 {
+		signer.ttl = defaultTTL
+	}
+
+	for id, key := range cfg.Keys {
+		if id == "" || strings.Contains(id, ".") {
+			return nil, fmt.Errorf("unsubscribe key id %q must be non-empty and must not contain dots", id)
+		}
+
+		secret, err := key.secret()
+		if err != nil {
+			return nil, fmt.Errorf("unsubscribe key %q: %w", id, err)
+		}
+
+		signer.keys[id] = []byte(secret)
+	}
+
+	if _, ok := signer.keys[cfg.SigningKey]; !ok {
+		return nil, fmt.Errorf("unsubscribe signing key %q is not configured", cfg.SigningKey)
+	}
+
+	return signer, nil
+}
+
+// TTL is the lifetime of tokens minted without one.
+func (s *Signer) TTL() time.Duration {
+	return s.ttl
+}
+
+// Sign returns the token of the claims, signed with the signing key.
+func (s *Signer) Sign(claims Claims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal unsubscribe claims: %w", err)
+	}
+
+	signed := s.signingKey + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sign(s.keys[s.signingKey], signed)), nil
+}
+
+// Verify checks the signature and expiry of the token at now and returns its
+// claims.
+func (s *Signer) Verify(token string, now time.Time) (Claims, error) {
+	var claims Claims
+
+	keyID, rest, ok := strings.Cut(token, ".")
+	if !ok {
+		return claims, ErrInvalidToken
+	}
+
+	encodedPayload, encodedSignature, ok := strings.Cut(rest, ".")
+	if !ok {
+		return claims, ErrInvalidToken
+	}
+
+	key, ok := s.keys[keyID]
+	if !ok {
+		return claims, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, sign(key, keyID+"."+encodedPayload)) {
+		return claims, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return claims, ErrInvalidToken
+	}
+
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return claims, ErrInvalidToken
+	}
+
+	if now.Unix() >= claims.ExpiresAt {
+		return claims, ErrExpiredToken
+	}
+
+	return claims, nil
+}
+
+func sign(key []byte, signed string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(signed))
+
+	return mac.Sum(nil)
+}
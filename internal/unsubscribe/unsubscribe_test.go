@@ -0,0 +1,217 @@
+package unsubscribe
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+)
+
+const (
+	oldSecret = "0123456789abcdef0123456789abcdef-old"
+	newSecret = "0123456789abcdef0123456789abcdef-new"
+)
+
+func newSigner(t *testing.T, signingKey string, keys ...string) *Signer {
+	t.Helper()
+
+	secrets := map[string]string{"old": oldSecret, "new": newSecret}
+	cfg := Config{Keys: make(map[string]Key), SigningKey: signingKey}
+
+	for _, id := range keys {
+		env := "UNSUBSCRIBE_TEST_SECRET_" + strings.ToUpper(id)
+		t.Setenv(env, secrets[id])
+		cfg.Keys[id] = Key{SecretEnv: env}
+	}
+
+	signer, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	return signer
+}
+
+func TestSignerVerify(t *testing.T) {
+	now := time.Date(2025, 1, 28, 12, 0, 0, 0, time.UTC)
+	claims := Claims{
+		SystemID:  "system",
+		UserID:    "user",
+		Channel:   rpcv1.Channel_CHANNEL_EMAIL,
+		Category:  "marketing",
+		ExpiresAt: now.Add(time.Hour).Unix(),
+	}
+
+	oldSigner := newSigner(t, "old", "old")
+	rotatedSigner := newSigner(t, "new", "old", "new")
+	newOnlySigner := newSigner(t, "new", "new")
+
+	oldToken, err := oldSigner.Sign(claims)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	newToken, err := rotatedSigner.Sign(claims)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	keyID, payload, signature := splitToken(t, oldToken)
+
+	tamperedClaims := claims
+	tamperedClaims.UserID = "someone else"
+
+	tests := []struct {
+		name    string
+		signer  *Signer
+		token   string
+		at      time.Time
+		wantErr error
+	}{
+		{name: "valid", signer: oldSigner, token: oldToken, at: now},
+		{name: "signed with the new key", signer: rotatedSigner, token: newToken, at: now},
+		{name: "rotated out key still verifies", signer: rotatedSigner, token: oldToken, at: now},
+		{name: "removed key", signer: newOnlySigner, token: oldToken, at: now, wantErr: ErrInvalidToken},
+		{
+			name:    "unknown key",
+			signer:  oldSigner,
+			token:   "other." + payload + "." + signature,
+			at:      now,
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "key id swapped",
+			signer:  rotatedSigner,
+			token:   "new." + payload + "." + signature,
+			at:      now,
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "tampered payload",
+			signer:  oldSigner,
+			token:   keyID + "." + encodeClaims(t, tamperedClaims) + "." + signature,
+			at:      now,
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "tampered signature",
+			signer:  oldSigner,
+			token:   keyID + "." + payload + "." + base64.RawURLEncoding.EncodeToString([]byte("forged")),
+			at:      now,
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "malformed signature",
+			signer:  oldSigner,
+			token:   keyID + "." + payload + ".!!",
+			at:      now,
+			wantErr: ErrInvalidToken,
+		},
+		{name: "missing signature", signer: oldSigner, token: keyID + "." + payload, at: now, wantErr: ErrInvalidToken},
+		{name: "empty", signer: oldSigner, token: "", at: now, wantErr: ErrInvalidToken},
+		{
+			name:    "just before expiry",
+			signer:  oldSigner,
+			token:   oldToken,
+			at:      time.Unix(claims.ExpiresAt, 0).Add(-time.Second),
+			wantErr: nil,
+		},
+		{
+			name:    "at expiry",
+			signer:  oldSigner,
+			token:   oldToken,
+			at:      time.Unix(claims.ExpiresAt, 0),
+			wantErr: ErrExpiredToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.signer.Verify(tt.token, tt.at)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && got != claims {
+				t.Errorf("Verify() = %+v, want %+v", got, claims)
+			}
+		})
+	}
+}
+
+func TestNewRejectsInvalidKeys(t *testing.T) {
+	tests := []struct {
+		name   string
+		env    string
+		config Config
+	}{
+		{
+			name:   "secret in the config",
+			env:    oldSecret,
+			config: Config{SigningKey: "k", Keys: map[string]Key{"k": {Secret: oldSecret, SecretEnv: "UNSUBSCRIBE_TEST_SECRET"}}},
+		},
+		{
+			name:   "no secret env",
+			env:    oldSecret,
+			config: Config{SigningKey: "k", Keys: map[string]Key{"k": {}}},
+		},
+		{
+			name:   "unset env",
+			config: Config{SigningKey: "k", Keys: map[string]Key{"k": {SecretEnv: "UNSUBSCRIBE_TEST_SECRET"}}},
+		},
+		{
+			name:   "short secret",
+			env:    "too short",
+			config: Config{SigningKey: "k", Keys: map[string]Key{"k": {SecretEnv: "UNSUBSCRIBE_TEST_SECRET"}}},
+		},
+		{
+			name:   "key id with a dot",
+			env:    oldSecret,
+			config: Config{SigningKey: "k.1", Keys: map[string]Key{"k.1": {SecretEnv: "UNSUBSCRIBE_TEST_SECRET"}}},
+		},
+		{
+			name:   "signing key not configured",
+			env:    oldSecret,
+			config: Config{SigningKey: "other", Keys: map[string]Key{"k": {SecretEnv: "UNSUBSCRIBE_TEST_SECRET"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("UNSUBSCRIBE_TEST_SECRET", tt.env)
+
+			if _, err := New(tt.config); err == nil {
+				t.Error("New() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func splitToken(t *testing.T, token string) (string, string, string) {
+	t.Helper()
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("token %q has %d parts, want 3", token, len(parts))
+	}
+
+	return parts[0], parts[1], parts[2]
+}
+
+func encodeClaims(t *testing.T, claims Claims) string {
+	t.Helper()
+
+	signer := &Signer{keys: map[string][]byte{"x": nil}, signingKey: "x"}
+
+	token, err := signer.Sign(claims)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	_, payload, _ := splitToken(t, token)
+
+	return payload
+}
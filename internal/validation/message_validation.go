@@ -66,7 +66,25 @@ func (v *JSONSchemaMessageValidator) Validate(message any) error {
 		)
 	}
 
-	result, err := gojsonschema.Validate(schema, gojsonschema.NewGoLoader(jsonData))
+	return validateLoader(schema, gojsonschema.NewGoLoader(jsonData), messageType)
+}
+
+// ValidateJSON validates the raw JSON of a message against the schema of the
+// message type. Unlike Validate it sees the document as received, so fields
+// the message type does not declare are caught by additionalProperties.
+func (v *JSONSchemaMessageValidator) ValidateJSON(message any, data []byte) error {
+	messageType := getTypeName(message)
+
+	schema, exists := v.schemaMap[messageType]
+	if !exists {
+		return errors.NewInvalidMessageError("No JSON schema found for "+messageType, nil)
+	}
+
+	return validateLoader(schema, gojsonschema.NewBytesLoader(data), messageType)
+}
+
+func validateLoader(schema, document gojsonschema.JSONLoader, messageType string) error {
+	result, err := gojsonschema.Validate(schema, document)
 	if err != nil {
 		return errors.NewInvalidMessageError("Failed to validate JSON for "+messageType, err)
 	}
@@ -0,0 +1,242 @@
+package watch
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/notification-system-moxicom/persistence-service/internal/repository"
+	rpcv1 "github.com/notification-system-moxicom/persistence-service/pkg/proto/gen/persistence/v1"
+	"github.com/notification-system-moxicom/persistence-service/pkg/util/generic"
+)
+
+const (
+	defaultBufferSize        = 256
+	defaultReconnectInterval = 5 * time.Second
+	defaultPollInterval      = time.Second
+
+	sequenceBatchSize = 1000
+	publishBatchSize  = 500
+)
+
+type Config struct {
+	// BufferSize is how many events a subscriber may fall behind before it is
+	// dropped and has to catch up from the events table.
+	BufferSize        int           `yaml:"buffer_size"`
+	ReconnectInterval time.Duration `yaml:"reconnect_interval"`
+	// PollInterval bounds how late events are published when notifications
+	// about them are missed, e.g. while the listener reconnects.
+	PollInterval time.Duration `yaml:"poll_interval"`
+}
+
+type Store interface {
+	SequenceNotificationEvents(ctx context.Context, limit int) (int, error)
+	LastNotificationEventPosition(ctx context.Context) (int64, error)
+	ListNotificationEvents(
+		ctx context.Context,
+		filter repository.NotificationEventFilter,
+		afterPosition int64,
+		limit int,
+	) ([]*rpcv1.NotificationEvent, error)
+	ListenNotificationEvents(ctx context.Context, wake func()) error
+}
+
+// Hub fans notification events out to subscribers. Events are published in
+// position order from the events table, a listener only wakes the hub up when
+// new ones are stored. Delivery never blocks the hub: a subscriber whose
+// buffer is full is dropped, its events channel is closed and it is expected
+// to resume from the last event it has seen.
+type Hub struct {
+	store  Store
+	config Config
+
+	// position is the last published position, -1 until it is known. Only
+	// Run touches it.
+	position int64
+
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+}
+
+func NewHub(store Store, cfg Config) *Hub {
+	cfg.BufferSize = generic.DefaultIfZero(cfg.BufferSize, defaultBufferSize)
+	cfg.ReconnectInterval = generic.DefaultIfZero(cfg.ReconnectInterval, defaultReconnectInterval)
+	cfg.PollInterval = generic.DefaultIfZero(cfg.PollInterval, defaultPollInterval)
+
+	return &Hub{
+		store:       store,
+		config:      cfg,
+		position:    -1,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Run publishes events until ctx is canceled, whenever the listener hears of
+// new ones and at least every PollInterval.
+func (h *Hub) Run(ctx context.Context) {
+	slog.Info("notification event hub started")
+
+	wake := make(chan struct{}, 1)
+
+	go h.listen(ctx, func() {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	})
+
+	ticker := time.NewTicker(h.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := h.publishNew(ctx); err != nil && ctx.Err() == nil {
+			slog.Error("failed to publish notification events", slog.Any("error", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("context canceled, stopping notification event hub")
+			h.dropAll()
+
+			return
+		case <-wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// listen keeps the listener connected until ctx is canceled.
+func (h *Hub) listen(ctx context.Context, wake func()) {
+	for {
+		if err := h.store.ListenNotificationEvents(ctx, wake); err != nil {
+			slog.Error("notification event listener failed", slog.Any("error", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(h.config.ReconnectInterval):
+		}
+	}
+}
+
+// publishNew sequences the stored events and publishes the ones after the
+// last published position.
+func (h *Hub) publishNew(ctx context.Context) error {
+	if h.position < 0 {
+		position, err := h.store.LastNotificationEventPosition(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Subscribers that came in before the hub knew where to start may have
+		// replayed less than what it skips now.
+		h.position = position
+		h.dropAll()
+	}
+
+	for {
+		sequenced, err := h.store.SequenceNotificationEvents(ctx, sequenceBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if sequenced < sequenceBatchSize {
+			break
+		}
+	}
+
+	for {
+		events, err := h.store.ListNotificationEvents(ctx, repository.NotificationEventFilter{}, h.position, publishBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			h.publish(event)
+			h.position = event.GetEventId()
+		}
+
+		if len(events) < publishBatchSize {
+			return nil
+		}
+	}
+}
+
+// Subscribe starts delivering events matching the filter. The caller must
+// Close the subscription once done.
+func (h *Hub) Subscribe(filter repository.NotificationEventFilter) *Subscription {
+	sub := &Subscription{
+		hub:    h,
+		filter: filter,
+		events: make(chan *rpcv1.NotificationEvent, h.config.BufferSize),
+	}
+
+	h.mu.Lock()
+	h.subscribers[sub] = struct{}{}
+	h.mu.Unlock()
+
+	return sub
+}
+
+func (h *Hub) publish(event *rpcv1.NotificationEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers {
+		if !sub.filter.Matches(event) {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			slog.Warn("dropping slow notification event subscriber", slog.Int64("event_id", event.GetEventId()))
+			h.removeLocked(sub)
+		}
+	}
+}
+
+// dropAll drops every subscriber.
+func (h *Hub) dropAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers {
+		h.removeLocked(sub)
+	}
+}
+
+func (h *Hub) remove(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.removeLocked(sub)
+}
+
+func (h *Hub) removeLocked(sub *Subscription) {
+	if _, ok := h.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(h.subscribers, sub)
+	close(sub.events)
+}
+
+// Subscription receives the events of one subscriber.
+type Subscription struct {
+	hub    *Hub
+	filter repository.NotificationEventFilter
+	events chan *rpcv1.NotificationEvent
+}
+
+// Events returns the channel events are delivered to. It is closed when the
+// subscriber is dropped or closed.
+func (s *Subscription) Events() <-chan *rpcv1.NotificationEvent {
+	return s.events
+}
+
+func (s *Subscription) Close() {
+	s.hub.remove(s)
+}
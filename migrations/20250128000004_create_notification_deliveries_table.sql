@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE delivery_status AS ENUM ('queued', 'sending', 'delivered', 'failed', 'bounced');
+CREATE TYPE delivery_channel AS ENUM ('email', 'sms', 'telegram');
+
+ALTER TABLE notification_recipients
+    ADD COLUMN status delivery_status NOT NULL DEFAULT 'queued',
+    ADD COLUMN updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
+CREATE TABLE notification_deliveries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    recipient_id UUID NOT NULL,
+    channel delivery_channel NOT NULL,
+    status delivery_status NOT NULL DEFAULT 'queued',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    last_attempt_at TIMESTAMP WITH TIME ZONE,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_deliveries_recipient FOREIGN KEY (recipient_id) REFERENCES notification_recipients(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_deliveries_recipient_channel ON notification_deliveries(recipient_id, channel);
+CREATE INDEX idx_deliveries_status ON notification_deliveries(status);
+CREATE UNIQUE INDEX idx_recipients_notification_user ON notification_recipients(notification_id, user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_recipients_notification_user;
+DROP TABLE IF EXISTS notification_deliveries;
+
+ALTER TABLE notification_recipients
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS updated_at;
+
+DROP TYPE IF EXISTS delivery_channel;
+DROP TYPE IF EXISTS delivery_status;
+-- +goose StatementEnd
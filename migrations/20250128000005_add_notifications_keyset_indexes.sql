@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_notifications_created_at;
+
+CREATE INDEX idx_notifications_created_at ON notifications(created_at, id);
+CREATE INDEX idx_notifications_system_created_at ON notifications(system_id, created_at, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_notifications_system_created_at;
+DROP INDEX IF EXISTS idx_notifications_created_at;
+
+CREATE INDEX idx_notifications_created_at ON notifications(created_at);
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE notification_status ADD VALUE IF NOT EXISTS 'scheduled';
+
+ALTER TABLE notifications ADD COLUMN send_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_notifications_scheduled_send_at ON notifications(send_at) WHERE status = 'scheduled';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_notifications_scheduled_send_at;
+
+UPDATE notifications SET status = 'pending' WHERE status = 'scheduled';
+
+ALTER TABLE notifications DROP COLUMN IF EXISTS send_at;
+
+ALTER TYPE notification_status RENAME TO notification_status_old;
+
+CREATE TYPE notification_status AS ENUM ('pending', 'sent');
+
+ALTER TABLE notifications
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE notification_status USING status::text::notification_status,
+    ALTER COLUMN status SET DEFAULT 'pending';
+
+DROP TYPE notification_status_old;
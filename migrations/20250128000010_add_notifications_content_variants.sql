@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE notifications ADD COLUMN content_variants JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE notifications DROP COLUMN IF EXISTS content_variants;
+-- +goose StatementEnd
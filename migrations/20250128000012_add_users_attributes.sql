@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN attributes JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+CREATE INDEX idx_users_attributes ON users USING GIN (attributes);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_users_attributes;
+
+ALTER TABLE users DROP COLUMN IF EXISTS attributes;
+-- +goose StatementEnd
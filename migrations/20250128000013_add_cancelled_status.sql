@@ -0,0 +1,43 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE notification_status ADD VALUE IF NOT EXISTS 'cancelled';
+
+ALTER TYPE delivery_status ADD VALUE IF NOT EXISTS 'cancelled';
+
+-- +goose Down
+UPDATE notifications SET status = 'sent' WHERE status = 'cancelled';
+
+UPDATE notification_recipients SET status = 'failed' WHERE status = 'cancelled';
+
+UPDATE notification_deliveries SET status = 'failed' WHERE status = 'cancelled';
+
+DROP INDEX IF EXISTS idx_notifications_scheduled_send_at;
+
+ALTER TYPE notification_status RENAME TO notification_status_old;
+
+CREATE TYPE notification_status AS ENUM ('pending', 'sent', 'scheduled');
+
+ALTER TABLE notifications
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE notification_status USING status::text::notification_status,
+    ALTER COLUMN status SET DEFAULT 'pending';
+
+DROP TYPE notification_status_old;
+
+CREATE INDEX idx_notifications_scheduled_send_at ON notifications(send_at) WHERE status = 'scheduled';
+
+ALTER TYPE delivery_status RENAME TO delivery_status_old;
+
+CREATE TYPE delivery_status AS ENUM ('queued', 'sending', 'delivered', 'failed', 'bounced');
+
+ALTER TABLE notification_recipients
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE delivery_status USING status::text::delivery_status,
+    ALTER COLUMN status SET DEFAULT 'queued';
+
+ALTER TABLE notification_deliveries
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE delivery_status USING status::text::delivery_status,
+    ALTER COLUMN status SET DEFAULT 'queued';
+
+DROP TYPE delivery_status_old;
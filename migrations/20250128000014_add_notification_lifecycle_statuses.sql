@@ -0,0 +1,33 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE notification_status ADD VALUE IF NOT EXISTS 'queued';
+
+ALTER TYPE notification_status ADD VALUE IF NOT EXISTS 'sending';
+
+ALTER TYPE notification_status ADD VALUE IF NOT EXISTS 'partially_failed';
+
+ALTER TYPE notification_status ADD VALUE IF NOT EXISTS 'failed';
+
+ALTER TYPE notification_status ADD VALUE IF NOT EXISTS 'expired';
+
+-- +goose Down
+UPDATE notifications SET status = 'pending' WHERE status IN ('queued', 'sending');
+
+UPDATE notifications SET status = 'sent' WHERE status IN ('partially_failed', 'failed');
+
+UPDATE notifications SET status = 'cancelled' WHERE status = 'expired';
+
+DROP INDEX IF EXISTS idx_notifications_scheduled_send_at;
+
+ALTER TYPE notification_status RENAME TO notification_status_old;
+
+CREATE TYPE notification_status AS ENUM ('pending', 'sent', 'scheduled', 'cancelled');
+
+ALTER TABLE notifications
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE notification_status USING status::text::notification_status,
+    ALTER COLUMN status SET DEFAULT 'pending';
+
+DROP TYPE notification_status_old;
+
+CREATE INDEX idx_notifications_scheduled_send_at ON notifications(send_at) WHERE status = 'scheduled';
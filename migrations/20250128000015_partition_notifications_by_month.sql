@@ -0,0 +1,155 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Partitioned tables can only be referenced by keys that include the partition
+-- key, so deliveries lose their foreign key to recipients and recipients lose
+-- theirs to notifications. Partition maintenance removes dependent rows instead.
+ALTER TABLE notification_deliveries DROP CONSTRAINT IF EXISTS fk_deliveries_recipient;
+
+ALTER TABLE notification_recipients RENAME TO notification_recipients_old;
+ALTER INDEX notification_recipients_pkey RENAME TO notification_recipients_old_pkey;
+ALTER TABLE notifications RENAME TO notifications_old;
+ALTER INDEX notifications_pkey RENAME TO notifications_old_pkey;
+
+CREATE TABLE notifications (
+    id UUID NOT NULL DEFAULT uuid_generate_v4(),
+    system_id UUID NOT NULL,
+    content TEXT NOT NULL,
+    content_variants JSONB,
+    status notification_status NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    send_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (id, created_at),
+    CONSTRAINT fk_notifications_system FOREIGN KEY (system_id) REFERENCES systems(id) ON DELETE CASCADE
+) PARTITION BY RANGE (created_at);
+
+-- Recipients share the created_at of their notification, so both tables are
+-- partitioned along the same month boundaries.
+CREATE TABLE notification_recipients (
+    id UUID NOT NULL DEFAULT uuid_generate_v4(),
+    notification_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    status delivery_status NOT NULL DEFAULT 'queued',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id, created_at),
+    CONSTRAINT fk_recipients_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE notifications_default PARTITION OF notifications DEFAULT;
+CREATE TABLE notification_recipients_default PARTITION OF notification_recipients DEFAULT;
+
+-- Monthly partitions (UTC) from the oldest stored notification up to three
+-- months ahead; later months are created by the partitions command.
+DO $$
+DECLARE
+    month_start DATE;
+    last_month DATE := date_trunc('month', NOW() AT TIME ZONE 'UTC') + INTERVAL '3 months';
+    lower_bound TEXT;
+    upper_bound TEXT;
+BEGIN
+    SELECT date_trunc('month', COALESCE(MIN(created_at), NOW()) AT TIME ZONE 'UTC')
+    INTO month_start
+    FROM notifications_old;
+
+    WHILE month_start <= last_month LOOP
+        lower_bound := to_char(month_start, 'YYYY-MM-DD') || ' 00:00:00+00';
+        upper_bound := to_char(month_start + INTERVAL '1 month', 'YYYY-MM-DD') || ' 00:00:00+00';
+
+        EXECUTE format(
+            'CREATE TABLE %I PARTITION OF notifications FOR VALUES FROM (%L) TO (%L)',
+            'notifications_p' || to_char(month_start, 'YYYY_MM'), lower_bound, upper_bound
+        );
+
+        EXECUTE format(
+            'CREATE TABLE %I PARTITION OF notification_recipients FOR VALUES FROM (%L) TO (%L)',
+            'notification_recipients_p' || to_char(month_start, 'YYYY_MM'), lower_bound, upper_bound
+        );
+
+        month_start := month_start + INTERVAL '1 month';
+    END LOOP;
+END
+$$;
+
+INSERT INTO notifications (id, system_id, content, content_variants, status, created_at, send_at)
+SELECT id, system_id, content, content_variants, status, created_at, send_at
+FROM notifications_old;
+
+INSERT INTO notification_recipients (id, notification_id, user_id, status, created_at, updated_at)
+SELECT r.id, r.notification_id, r.user_id, r.status, n.created_at, r.updated_at
+FROM notification_recipients_old r
+JOIN notifications_old n ON n.id = r.notification_id;
+
+DROP TABLE notification_recipients_old;
+DROP TABLE notifications_old;
+
+CREATE INDEX idx_notifications_system_id ON notifications(system_id);
+CREATE INDEX idx_notifications_created_at ON notifications(created_at, id);
+CREATE INDEX idx_notifications_system_created_at ON notifications(system_id, created_at, id);
+CREATE INDEX idx_notifications_status ON notifications(status);
+CREATE INDEX idx_notifications_scheduled_send_at ON notifications(send_at) WHERE status = 'scheduled';
+
+CREATE INDEX idx_recipients_notification_id ON notification_recipients(notification_id);
+CREATE INDEX idx_recipients_user_id ON notification_recipients(user_id);
+CREATE UNIQUE INDEX idx_recipients_notification_user ON notification_recipients(notification_id, user_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE notification_recipients RENAME TO notification_recipients_partitioned;
+ALTER INDEX notification_recipients_pkey RENAME TO notification_recipients_partitioned_pkey;
+ALTER TABLE notifications RENAME TO notifications_partitioned;
+ALTER INDEX notifications_pkey RENAME TO notifications_partitioned_pkey;
+
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    system_id UUID NOT NULL,
+    content TEXT NOT NULL,
+    status notification_status NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    send_at TIMESTAMP WITH TIME ZONE,
+    content_variants JSONB
+);
+
+INSERT INTO notifications (id, system_id, content, status, created_at, send_at, content_variants)
+SELECT id, system_id, content, status, created_at, send_at, content_variants
+FROM notifications_partitioned;
+
+CREATE TABLE notification_recipients (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    notification_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    status delivery_status NOT NULL DEFAULT 'queued',
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO notification_recipients (id, notification_id, user_id, status, updated_at)
+SELECT r.id, r.notification_id, r.user_id, r.status, r.updated_at
+FROM notification_recipients_partitioned r
+WHERE EXISTS (SELECT 1 FROM notifications n WHERE n.id = r.notification_id);
+
+DROP TABLE notification_recipients_partitioned;
+DROP TABLE notifications_partitioned;
+
+DELETE FROM notification_deliveries d
+WHERE NOT EXISTS (SELECT 1 FROM notification_recipients r WHERE r.id = d.recipient_id);
+
+ALTER TABLE notifications
+    ADD CONSTRAINT fk_notifications_system FOREIGN KEY (system_id) REFERENCES systems(id) ON DELETE CASCADE;
+
+ALTER TABLE notification_recipients
+    ADD CONSTRAINT fk_recipients_notification FOREIGN KEY (notification_id) REFERENCES notifications(id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_recipients_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE notification_deliveries
+    ADD CONSTRAINT fk_deliveries_recipient FOREIGN KEY (recipient_id) REFERENCES notification_recipients(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_notifications_system_id ON notifications(system_id);
+CREATE INDEX idx_notifications_created_at ON notifications(created_at, id);
+CREATE INDEX idx_notifications_system_created_at ON notifications(system_id, created_at, id);
+CREATE INDEX idx_notifications_status ON notifications(status);
+CREATE INDEX idx_notifications_scheduled_send_at ON notifications(send_at) WHERE status = 'scheduled';
+
+CREATE INDEX idx_recipients_notification_id ON notification_recipients(notification_id);
+CREATE INDEX idx_recipients_user_id ON notification_recipients(user_id);
+CREATE UNIQUE INDEX idx_recipients_notification_user ON notification_recipients(notification_id, user_id);
+-- +goose StatementEnd
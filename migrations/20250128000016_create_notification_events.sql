@@ -0,0 +1,90 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Status changes of notifications and their recipients, written by triggers.
+-- Watchers resume from the table and follow new events through NOTIFY.
+CREATE TABLE notification_events (
+    id BIGSERIAL PRIMARY KEY,
+    notification_id UUID NOT NULL,
+    system_id UUID NOT NULL,
+    recipient_id UUID,
+    user_id UUID,
+    status TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notification_events_notification_id ON notification_events(notification_id, id);
+CREATE INDEX idx_notification_events_system_id ON notification_events(system_id, id);
+CREATE INDEX idx_notification_events_created_at ON notification_events(created_at);
+
+CREATE FUNCTION record_notification_event(
+    p_notification_id UUID,
+    p_system_id UUID,
+    p_recipient_id UUID,
+    p_user_id UUID,
+    p_status TEXT
+) RETURNS VOID AS $$
+DECLARE
+    event notification_events;
+BEGIN
+    INSERT INTO notification_events (notification_id, system_id, recipient_id, user_id, status)
+    VALUES (p_notification_id, p_system_id, p_recipient_id, p_user_id, p_status)
+    RETURNING * INTO event;
+
+    PERFORM pg_notify('notification_events', json_build_object(
+        'id', event.id,
+        'notification_id', event.notification_id,
+        'system_id', event.system_id,
+        'recipient_id', event.recipient_id,
+        'user_id', event.user_id,
+        'status', event.status,
+        'created_at', extract(epoch FROM event.created_at)::BIGINT
+    )::TEXT);
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION notifications_status_event() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' OR NEW.status IS DISTINCT FROM OLD.status THEN
+        PERFORM record_notification_event(NEW.id, NEW.system_id, NULL, NULL, NEW.status::TEXT);
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION notification_recipients_status_event() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.status IS DISTINCT FROM OLD.status THEN
+        PERFORM record_notification_event(
+            NEW.notification_id,
+            (SELECT n.system_id FROM notifications n WHERE n.id = NEW.notification_id),
+            NEW.id,
+            NEW.user_id,
+            NEW.status::TEXT
+        );
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Recipient inserts are left out on purpose: a broadcast would emit one event
+-- per user, the notification insert already announces them.
+CREATE TRIGGER trg_notifications_status_event
+    AFTER INSERT OR UPDATE OF status ON notifications
+    FOR EACH ROW EXECUTE FUNCTION notifications_status_event();
+
+CREATE TRIGGER trg_notification_recipients_status_event
+    AFTER UPDATE OF status ON notification_recipients
+    FOR EACH ROW EXECUTE FUNCTION notification_recipients_status_event();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_notification_recipients_status_event ON notification_recipients;
+DROP TRIGGER IF EXISTS trg_notifications_status_event ON notifications;
+DROP FUNCTION IF EXISTS notification_recipients_status_event();
+DROP FUNCTION IF EXISTS notifications_status_event();
+DROP FUNCTION IF EXISTS record_notification_event(UUID, UUID, UUID, UUID, TEXT);
+DROP TABLE IF EXISTS notification_events;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE delivery_status ADD VALUE IF NOT EXISTS 'deduplicated';
+
+ALTER TABLE systems ADD COLUMN dedup_window_seconds INTEGER NOT NULL DEFAULT 0;
+
+-- Caller supplied dedup key, or a hash of the content when none is given.
+ALTER TABLE notifications ADD COLUMN dedup_key TEXT;
+
+CREATE INDEX idx_notifications_dedup_key ON notifications(system_id, dedup_key, created_at) WHERE dedup_key IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_notifications_dedup_key;
+
+ALTER TABLE notifications DROP COLUMN IF EXISTS dedup_key;
+
+ALTER TABLE systems DROP COLUMN IF EXISTS dedup_window_seconds;
+
+UPDATE notification_recipients SET status = 'cancelled' WHERE status = 'deduplicated';
+
+UPDATE notification_deliveries SET status = 'cancelled' WHERE status = 'deduplicated';
+
+-- The status trigger depends on the column type, it is recreated afterwards.
+DROP TRIGGER IF EXISTS trg_notification_recipients_status_event ON notification_recipients;
+
+ALTER TYPE delivery_status RENAME TO delivery_status_old;
+
+CREATE TYPE delivery_status AS ENUM ('queued', 'sending', 'delivered', 'failed', 'bounced', 'cancelled');
+
+ALTER TABLE notification_recipients
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE delivery_status USING status::text::delivery_status,
+    ALTER COLUMN status SET DEFAULT 'queued';
+
+ALTER TABLE notification_deliveries
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE delivery_status USING status::text::delivery_status,
+    ALTER COLUMN status SET DEFAULT 'queued';
+
+DROP TYPE delivery_status_old;
+
+CREATE TRIGGER trg_notification_recipients_status_event
+    AFTER UPDATE OF status ON notification_recipients
+    FOR EACH ROW EXECUTE FUNCTION notification_recipients_status_event();
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Contracted notification volumes per system, 0 means unlimited.
+CREATE TABLE system_quotas (
+    system_id UUID PRIMARY KEY,
+    daily_limit BIGINT NOT NULL DEFAULT 0,
+    monthly_limit BIGINT NOT NULL DEFAULT 0,
+    soft_limit_percent INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_quotas_system FOREIGN KEY (system_id) REFERENCES systems(id) ON DELETE CASCADE,
+    CONSTRAINT chk_quotas_limits CHECK (daily_limit >= 0 AND monthly_limit >= 0),
+    CONSTRAINT chk_quotas_soft_limit CHECK (soft_limit_percent BETWEEN 0 AND 100)
+);
+
+-- Notifications created per system and UTC day or month.
+CREATE TABLE system_usage (
+    system_id UUID NOT NULL,
+    period VARCHAR(16) NOT NULL,
+    period_start DATE NOT NULL,
+    used BIGINT NOT NULL DEFAULT 0,
+    warned_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (system_id, period, period_start),
+    CONSTRAINT fk_usage_system FOREIGN KEY (system_id) REFERENCES systems(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS system_usage;
+DROP TABLE IF EXISTS system_quotas;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Quiet hours are JSON arrays of {"start": "HH:MM", "end": "HH:MM"} local
+-- times. Users without their own settings fall back to the system defaults.
+ALTER TABLE systems
+    ADD COLUMN default_timezone TEXT,
+    ADD COLUMN default_quiet_hours JSONB;
+
+ALTER TABLE users
+    ADD COLUMN timezone TEXT,
+    ADD COLUMN quiet_hours JSONB;
+
+-- Recipients reached during their quiet hours are held back until then.
+ALTER TABLE notification_recipients ADD COLUMN deliver_after TIMESTAMP WITH TIME ZONE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE notification_recipients DROP COLUMN IF EXISTS deliver_after;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS quiet_hours,
+    DROP COLUMN IF EXISTS timezone;
+
+ALTER TABLE systems
+    DROP COLUMN IF EXISTS default_quiet_hours,
+    DROP COLUMN IF EXISTS default_timezone;
+-- +goose StatementEnd
@@ -0,0 +1,53 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE delivery_status ADD VALUE IF NOT EXISTS 'suppressed';
+
+-- Why a recipient was not delivered to, e.g. suppressed_by_preference.
+ALTER TABLE notification_recipients ADD COLUMN status_reason TEXT;
+
+ALTER TABLE notifications ADD COLUMN category VARCHAR(64);
+
+-- Channels are stored by name, e.g. email. Users without a row receive every
+-- category on every channel they have an address for.
+CREATE TABLE user_preferences (
+    user_id UUID PRIMARY KEY,
+    disabled_channels TEXT[] NOT NULL DEFAULT '{}',
+    muted_categories TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_preferences_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_preferences;
+
+ALTER TABLE notifications DROP COLUMN IF EXISTS category;
+
+ALTER TABLE notification_recipients DROP COLUMN IF EXISTS status_reason;
+
+UPDATE notification_recipients SET status = 'cancelled' WHERE status = 'suppressed';
+
+UPDATE notification_deliveries SET status = 'cancelled' WHERE status = 'suppressed';
+
+-- The status trigger depends on the column type, it is recreated afterwards.
+DROP TRIGGER IF EXISTS trg_notification_recipients_status_event ON notification_recipients;
+
+ALTER TYPE delivery_status RENAME TO delivery_status_old;
+
+CREATE TYPE delivery_status AS ENUM ('queued', 'sending', 'delivered', 'failed', 'bounced', 'cancelled', 'deduplicated');
+
+ALTER TABLE notification_recipients
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE delivery_status USING status::text::delivery_status,
+    ALTER COLUMN status SET DEFAULT 'queued';
+
+ALTER TABLE notification_deliveries
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE delivery_status USING status::text::delivery_status,
+    ALTER COLUMN status SET DEFAULT 'queued';
+
+DROP TYPE delivery_status_old;
+
+CREATE TRIGGER trg_notification_recipients_status_event
+    AFTER UPDATE OF status ON notification_recipients
+    FOR EACH ROW EXECUTE FUNCTION notification_recipients_status_event();
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE notification_categories (
+    system_id UUID NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    default_opt_in BOOLEAN NOT NULL DEFAULT TRUE,
+    mandatory BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (system_id, name),
+    CONSTRAINT fk_categories_system FOREIGN KEY (system_id) REFERENCES systems(id) ON DELETE CASCADE
+);
+
+-- Categories that are opt-out by default and that the user subscribed to.
+ALTER TABLE user_preferences ADD COLUMN subscribed_categories TEXT[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_preferences DROP COLUMN IF EXISTS subscribed_categories;
+
+DROP TABLE IF EXISTS notification_categories;
+-- +goose StatementEnd
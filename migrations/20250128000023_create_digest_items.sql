@@ -0,0 +1,66 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE delivery_status ADD VALUE IF NOT EXISTS 'digested';
+
+-- Digest modes are hourly or daily. NULL on preferences follows the category,
+-- NULL on categories is off.
+ALTER TABLE notification_categories ADD COLUMN digest_mode VARCHAR(16);
+
+ALTER TABLE user_preferences ADD COLUMN digest_mode VARCHAR(16);
+
+-- Low-priority notifications waiting to be rolled up into a digest. Rolled up
+-- items keep the digest notification they were included in.
+CREATE TABLE digest_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    system_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    notification_id UUID NOT NULL,
+    notification_created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    recipient_id UUID NOT NULL,
+    content TEXT NOT NULL,
+    due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    digest_notification_id UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_digest_items_system FOREIGN KEY (system_id) REFERENCES systems(id) ON DELETE CASCADE,
+    CONSTRAINT fk_digest_items_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_digest_items_due ON digest_items(due_at) WHERE digest_notification_id IS NULL;
+
+CREATE UNIQUE INDEX idx_digest_items_recipient ON digest_items(recipient_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS digest_items;
+
+ALTER TABLE user_preferences DROP COLUMN IF EXISTS digest_mode;
+
+ALTER TABLE notification_categories DROP COLUMN IF EXISTS digest_mode;
+
+UPDATE notification_recipients SET status = 'cancelled' WHERE status = 'digested';
+
+UPDATE notification_deliveries SET status = 'cancelled' WHERE status = 'digested';
+
+-- The status trigger depends on the column type, it is recreated afterwards.
+DROP TRIGGER IF EXISTS trg_notification_recipients_status_event ON notification_recipients;
+
+ALTER TYPE delivery_status RENAME TO delivery_status_old;
+
+CREATE TYPE delivery_status AS ENUM (
+    'queued', 'sending', 'delivered', 'failed', 'bounced', 'cancelled', 'deduplicated', 'suppressed'
+);
+
+ALTER TABLE notification_recipients
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE delivery_status USING status::text::delivery_status,
+    ALTER COLUMN status SET DEFAULT 'queued';
+
+ALTER TABLE notification_deliveries
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE delivery_status USING status::text::delivery_status,
+    ALTER COLUMN status SET DEFAULT 'queued';
+
+DROP TYPE delivery_status_old;
+
+CREATE TRIGGER trg_notification_recipients_status_event
+    AFTER UPDATE OF status ON notification_recipients
+    FOR EACH ROW EXECUTE FUNCTION notification_recipients_status_event();
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Recipients reference their notification by its full primary key, which
+-- includes the partition key, so the foreign key dropped when the tables were
+-- partitioned can be restored. notification_deliveries and digest_items are not
+-- partitioned and reference recipients by id only, a trigger removes their rows
+-- along with the recipients instead.
+DELETE FROM notification_recipients r
+WHERE NOT EXISTS (
+    SELECT 1 FROM notifications n WHERE n.id = r.notification_id AND n.created_at = r.created_at
+);
+
+DELETE FROM notification_deliveries d
+WHERE NOT EXISTS (SELECT 1 FROM notification_recipients r WHERE r.id = d.recipient_id);
+
+DELETE FROM digest_items i
+WHERE NOT EXISTS (SELECT 1 FROM notification_recipients r WHERE r.id = i.recipient_id);
+
+ALTER TABLE notification_recipients
+    ADD CONSTRAINT fk_recipients_notification FOREIGN KEY (notification_id, created_at)
+        REFERENCES notifications(id, created_at) ON DELETE CASCADE;
+
+CREATE FUNCTION notification_recipients_cleanup() RETURNS TRIGGER AS $$
+BEGIN
+    DELETE FROM notification_deliveries d USING deleted_recipients r WHERE d.recipient_id = r.id;
+    DELETE FROM digest_items i USING deleted_recipients r WHERE i.recipient_id = r.id;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Statement-level, so deleting a notification with many recipients cleans up
+-- in two statements. Partition maintenance moves and drops partitions without
+-- going through the parent table and removes dependent rows itself.
+CREATE TRIGGER trg_notification_recipients_cleanup
+    AFTER DELETE ON notification_recipients
+    REFERENCING OLD TABLE AS deleted_recipients
+    FOR EACH STATEMENT EXECUTE FUNCTION notification_recipients_cleanup();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_notification_recipients_cleanup ON notification_recipients;
+DROP FUNCTION IF EXISTS notification_recipients_cleanup();
+
+ALTER TABLE notification_recipients DROP CONSTRAINT IF EXISTS fk_recipients_notification;
+-- +goose StatementEnd
@@ -0,0 +1,89 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Event ids are taken when an event is written, not when its transaction
+-- commits, so an event with a lower id can become visible after a watcher has
+-- moved past it. Watchers follow positions instead, which a single sequencer
+-- assigns to committed events in order: once a position is visible, no lower
+-- position can appear anymore. Stored events keep their id as position, so
+-- existing cursors stay valid.
+ALTER TABLE notification_events ADD COLUMN position BIGINT;
+
+UPDATE notification_events SET position = id;
+
+CREATE UNIQUE INDEX idx_notification_events_position ON notification_events(position);
+CREATE INDEX idx_notification_events_unsequenced ON notification_events(id) WHERE position IS NULL;
+
+DROP INDEX IF EXISTS idx_notification_events_notification_id;
+DROP INDEX IF EXISTS idx_notification_events_system_id;
+
+CREATE INDEX idx_notification_events_notification_id ON notification_events(notification_id, position);
+CREATE INDEX idx_notification_events_system_id ON notification_events(system_id, position);
+
+-- The last assigned position. A table rather than MAX(position), so positions
+-- keep increasing after old events are deleted.
+CREATE TABLE notification_event_positions (
+    last_position BIGINT NOT NULL
+);
+
+INSERT INTO notification_event_positions (last_position)
+SELECT COALESCE(MAX(position), 0) FROM notification_events;
+
+-- The payload is no longer used: events are read from the table once they
+-- have a position, the notification only wakes the sequencer up.
+CREATE OR REPLACE FUNCTION record_notification_event(
+    p_notification_id UUID,
+    p_system_id UUID,
+    p_recipient_id UUID,
+    p_user_id UUID,
+    p_status TEXT
+) RETURNS VOID AS $$
+BEGIN
+    INSERT INTO notification_events (notification_id, system_id, recipient_id, user_id, status)
+    VALUES (p_notification_id, p_system_id, p_recipient_id, p_user_id, p_status);
+
+    PERFORM pg_notify('notification_events', '');
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION record_notification_event(
+    p_notification_id UUID,
+    p_system_id UUID,
+    p_recipient_id UUID,
+    p_user_id UUID,
+    p_status TEXT
+) RETURNS VOID AS $$
+DECLARE
+    event notification_events;
+BEGIN
+    INSERT INTO notification_events (notification_id, system_id, recipient_id, user_id, status)
+    VALUES (p_notification_id, p_system_id, p_recipient_id, p_user_id, p_status)
+    RETURNING * INTO event;
+
+    PERFORM pg_notify('notification_events', json_build_object(
+        'id', event.id,
+        'notification_id', event.notification_id,
+        'system_id', event.system_id,
+        'recipient_id', event.recipient_id,
+        'user_id', event.user_id,
+        'status', event.status,
+        'created_at', extract(epoch FROM event.created_at)::BIGINT
+    )::TEXT);
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS notification_event_positions;
+
+DROP INDEX IF EXISTS idx_notification_events_notification_id;
+DROP INDEX IF EXISTS idx_notification_events_system_id;
+
+CREATE INDEX idx_notification_events_notification_id ON notification_events(notification_id, id);
+CREATE INDEX idx_notification_events_system_id ON notification_events(system_id, id);
+
+DROP INDEX IF EXISTS idx_notification_events_unsequenced;
+DROP INDEX IF EXISTS idx_notification_events_position;
+
+ALTER TABLE notification_events DROP COLUMN IF EXISTS position;
+-- +goose StatementEnd
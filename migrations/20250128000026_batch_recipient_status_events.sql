@@ -0,0 +1,59 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Recipient status events are recorded once per statement: a status update of
+-- a broadcast touches thousands of recipients, the row-level trigger looked up
+-- their notification by id alone in every partition for each of them and sent
+-- as many notifications. Recipients share the created_at of their
+-- notification, so the join touches a single partition per notification.
+DROP TRIGGER IF EXISTS trg_notification_recipients_status_event ON notification_recipients;
+
+CREATE OR REPLACE FUNCTION notification_recipients_status_event() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO notification_events (notification_id, system_id, recipient_id, user_id, status)
+    SELECT r.notification_id, n.system_id, r.id, r.user_id, r.status::TEXT
+    FROM new_recipients r
+    JOIN old_recipients o ON o.id = r.id AND o.created_at = r.created_at
+    JOIN notifications n ON n.id = r.notification_id AND n.created_at = r.created_at
+    WHERE r.status IS DISTINCT FROM o.status
+    ORDER BY r.id;
+
+    IF FOUND THEN
+        PERFORM pg_notify('notification_events', '');
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Triggers with transition tables cannot be limited to UPDATE OF status, the
+-- function compares the statuses itself.
+CREATE TRIGGER trg_notification_recipients_status_event
+    AFTER UPDATE ON notification_recipients
+    REFERENCING OLD TABLE AS old_recipients NEW TABLE AS new_recipients
+    FOR EACH STATEMENT EXECUTE FUNCTION notification_recipients_status_event();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_notification_recipients_status_event ON notification_recipients;
+
+CREATE OR REPLACE FUNCTION notification_recipients_status_event() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.status IS DISTINCT FROM OLD.status THEN
+        PERFORM record_notification_event(
+            NEW.notification_id,
+            (SELECT n.system_id FROM notifications n WHERE n.id = NEW.notification_id),
+            NEW.id,
+            NEW.user_id,
+            NEW.status::TEXT
+        );
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_notification_recipients_status_event
+    AFTER UPDATE OF status ON notification_recipients
+    FOR EACH ROW EXECUTE FUNCTION notification_recipients_status_event();
+-- +goose StatementEnd
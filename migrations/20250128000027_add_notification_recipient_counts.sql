@@ -0,0 +1,102 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Notifications keep the number of their recipients in each status that
+-- drives the notification status, so a status report does not recount every
+-- recipient of a broadcast. failed includes bounced recipients.
+ALTER TABLE notifications
+    ADD COLUMN recipients_queued BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN recipients_sending BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN recipients_delivered BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN recipients_failed BIGINT NOT NULL DEFAULT 0;
+
+UPDATE notifications n
+SET recipients_queued = c.queued,
+    recipients_sending = c.sending,
+    recipients_delivered = c.delivered,
+    recipients_failed = c.failed
+FROM (
+    SELECT
+        notification_id,
+        created_at,
+        COUNT(*) FILTER (WHERE status = 'queued') AS queued,
+        COUNT(*) FILTER (WHERE status = 'sending') AS sending,
+        COUNT(*) FILTER (WHERE status = 'delivered') AS delivered,
+        COUNT(*) FILTER (WHERE status IN ('failed', 'bounced')) AS failed
+    FROM notification_recipients
+    GROUP BY notification_id, created_at
+) c
+WHERE n.id = c.notification_id AND n.created_at = c.created_at;
+
+-- The counters are adjusted once per statement from the transition tables,
+-- which are named the same by every trigger below. A trigger with transition
+-- tables can only fire on one event, the changes are picked by TG_OP.
+CREATE FUNCTION notification_recipient_counts() RETURNS TRIGGER AS $$
+DECLARE
+    changes TEXT;
+BEGIN
+    changes := CASE TG_OP
+        WHEN 'INSERT' THEN
+            'SELECT notification_id, created_at, status, 1 AS delta FROM new_recipients'
+        WHEN 'DELETE' THEN
+            'SELECT notification_id, created_at, status, -1 AS delta FROM old_recipients'
+        ELSE
+            'SELECT notification_id, created_at, status, 1 AS delta FROM new_recipients
+             UNION ALL
+             SELECT notification_id, created_at, status, -1 AS delta FROM old_recipients'
+    END;
+
+    EXECUTE format($sql$
+        UPDATE notifications n
+        SET recipients_queued = n.recipients_queued + c.queued,
+            recipients_sending = n.recipients_sending + c.sending,
+            recipients_delivered = n.recipients_delivered + c.delivered,
+            recipients_failed = n.recipients_failed + c.failed
+        FROM (
+            SELECT
+                notification_id,
+                created_at,
+                COALESCE(SUM(delta) FILTER (WHERE status = 'queued'), 0) AS queued,
+                COALESCE(SUM(delta) FILTER (WHERE status = 'sending'), 0) AS sending,
+                COALESCE(SUM(delta) FILTER (WHERE status = 'delivered'), 0) AS delivered,
+                COALESCE(SUM(delta) FILTER (WHERE status IN ('failed', 'bounced')), 0) AS failed
+            FROM (%s) changes
+            GROUP BY notification_id, created_at
+        ) c
+        WHERE n.id = c.notification_id
+            AND n.created_at = c.created_at
+            AND (c.queued, c.sending, c.delivered, c.failed) <> (0, 0, 0, 0)
+    $sql$, changes);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_notification_recipients_counts_insert
+    AFTER INSERT ON notification_recipients
+    REFERENCING NEW TABLE AS new_recipients
+    FOR EACH STATEMENT EXECUTE FUNCTION notification_recipient_counts();
+
+CREATE TRIGGER trg_notification_recipients_counts_update
+    AFTER UPDATE ON notification_recipients
+    REFERENCING OLD TABLE AS old_recipients NEW TABLE AS new_recipients
+    FOR EACH STATEMENT EXECUTE FUNCTION notification_recipient_counts();
+
+CREATE TRIGGER trg_notification_recipients_counts_delete
+    AFTER DELETE ON notification_recipients
+    REFERENCING OLD TABLE AS old_recipients
+    FOR EACH STATEMENT EXECUTE FUNCTION notification_recipient_counts();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_notification_recipients_counts_delete ON notification_recipients;
+DROP TRIGGER IF EXISTS trg_notification_recipients_counts_update ON notification_recipients;
+DROP TRIGGER IF EXISTS trg_notification_recipients_counts_insert ON notification_recipients;
+DROP FUNCTION IF EXISTS notification_recipient_counts();
+
+ALTER TABLE notifications
+    DROP COLUMN IF EXISTS recipients_queued,
+    DROP COLUMN IF EXISTS recipients_sending,
+    DROP COLUMN IF EXISTS recipients_delivered,
+    DROP COLUMN IF EXISTS recipients_failed;
+-- +goose StatementEnd
@@ -19,15 +19,41 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	PersistenceService_CreateSystem_FullMethodName = "/persistence.v1.PersistenceService/CreateSystem"
-	PersistenceService_GetSystems_FullMethodName   = "/persistence.v1.PersistenceService/GetSystems"
-	PersistenceService_UpdateSystem_FullMethodName = "/persistence.v1.PersistenceService/UpdateSystem"
-	PersistenceService_DeleteSystem_FullMethodName = "/persistence.v1.PersistenceService/DeleteSystem"
-	PersistenceService_AddUser_FullMethodName      = "/persistence.v1.PersistenceService/AddUser"
-	PersistenceService_GetUsers_FullMethodName     = "/persistence.v1.PersistenceService/GetUsers"
-	PersistenceService_UpdateUser_FullMethodName   = "/persistence.v1.PersistenceService/UpdateUser"
-	PersistenceService_DeleteUser_FullMethodName   = "/persistence.v1.PersistenceService/DeleteUser"
-	PersistenceService_Notify_FullMethodName       = "/persistence.v1.PersistenceService/Notify"
+	PersistenceService_CreateSystem_FullMethodName           = "/persistence.v1.PersistenceService/CreateSystem"
+	PersistenceService_GetSystems_FullMethodName             = "/persistence.v1.PersistenceService/GetSystems"
+	PersistenceService_UpdateSystem_FullMethodName           = "/persistence.v1.PersistenceService/UpdateSystem"
+	PersistenceService_DeleteSystem_FullMethodName           = "/persistence.v1.PersistenceService/DeleteSystem"
+	PersistenceService_SetQuota_FullMethodName               = "/persistence.v1.PersistenceService/SetQuota"
+	PersistenceService_GetUsage_FullMethodName               = "/persistence.v1.PersistenceService/GetUsage"
+	PersistenceService_CreateCategory_FullMethodName         = "/persistence.v1.PersistenceService/CreateCategory"
+	PersistenceService_ListCategories_FullMethodName         = "/persistence.v1.PersistenceService/ListCategories"
+	PersistenceService_UpdateCategory_FullMethodName         = "/persistence.v1.PersistenceService/UpdateCategory"
+	PersistenceService_DeleteCategory_FullMethodName         = "/persistence.v1.PersistenceService/DeleteCategory"
+	PersistenceService_AddUser_FullMethodName                = "/persistence.v1.PersistenceService/AddUser"
+	PersistenceService_GetUsers_FullMethodName               = "/persistence.v1.PersistenceService/GetUsers"
+	PersistenceService_UpdateUser_FullMethodName             = "/persistence.v1.PersistenceService/UpdateUser"
+	PersistenceService_DeleteUser_FullMethodName             = "/persistence.v1.PersistenceService/DeleteUser"
+	PersistenceService_GetPreferences_FullMethodName         = "/persistence.v1.PersistenceService/GetPreferences"
+	PersistenceService_SetPreferences_FullMethodName         = "/persistence.v1.PersistenceService/SetPreferences"
+	PersistenceService_SubscribeCategory_FullMethodName      = "/persistence.v1.PersistenceService/SubscribeCategory"
+	PersistenceService_UnsubscribeCategory_FullMethodName    = "/persistence.v1.PersistenceService/UnsubscribeCategory"
+	PersistenceService_CreateUnsubscribeToken_FullMethodName = "/persistence.v1.PersistenceService/CreateUnsubscribeToken"
+	PersistenceService_Notify_FullMethodName                 = "/persistence.v1.PersistenceService/Notify"
+	PersistenceService_BatchNotify_FullMethodName            = "/persistence.v1.PersistenceService/BatchNotify"
+	PersistenceService_GetNotification_FullMethodName        = "/persistence.v1.PersistenceService/GetNotification"
+	PersistenceService_ListNotifications_FullMethodName      = "/persistence.v1.PersistenceService/ListNotifications"
+	PersistenceService_UpdateRecipientStatus_FullMethodName  = "/persistence.v1.PersistenceService/UpdateRecipientStatus"
+	PersistenceService_CancelNotification_FullMethodName     = "/persistence.v1.PersistenceService/CancelNotification"
+	PersistenceService_WatchNotifications_FullMethodName     = "/persistence.v1.PersistenceService/WatchNotifications"
+	PersistenceService_CreateTemplate_FullMethodName         = "/persistence.v1.PersistenceService/CreateTemplate"
+	PersistenceService_GetTemplate_FullMethodName            = "/persistence.v1.PersistenceService/GetTemplate"
+	PersistenceService_ListTemplates_FullMethodName          = "/persistence.v1.PersistenceService/ListTemplates"
+	PersistenceService_UpdateTemplate_FullMethodName         = "/persistence.v1.PersistenceService/UpdateTemplate"
+	PersistenceService_DeleteTemplate_FullMethodName         = "/persistence.v1.PersistenceService/DeleteTemplate"
+	PersistenceService_CreateGroup_FullMethodName            = "/persistence.v1.PersistenceService/CreateGroup"
+	PersistenceService_DeleteGroup_FullMethodName            = "/persistence.v1.PersistenceService/DeleteGroup"
+	PersistenceService_AddGroupMembers_FullMethodName        = "/persistence.v1.PersistenceService/AddGroupMembers"
+	PersistenceService_RemoveGroupMembers_FullMethodName     = "/persistence.v1.PersistenceService/RemoveGroupMembers"
 )
 
 // PersistenceServiceClient is the client API for PersistenceService service.
@@ -38,13 +64,43 @@ type PersistenceServiceClient interface {
 	GetSystems(ctx context.Context, in *GetSystemsRequest, opts ...grpc.CallOption) (*Systems, error)
 	UpdateSystem(ctx context.Context, in *UpdateSystemRequest, opts ...grpc.CallOption) (*System, error)
 	DeleteSystem(ctx context.Context, in *DeleteSystemRequest, opts ...grpc.CallOption) (*InfoMessage, error)
+	// Quotas
+	SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*Quota, error)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*Usage, error)
+	// Notification categories
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*Categories, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*InfoMessage, error)
 	// Users CRUD
 	AddUser(ctx context.Context, in *AddUserRequest, opts ...grpc.CallOption) (*User, error)
 	GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*Users, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*InfoMessage, error)
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*Preferences, error)
+	SetPreferences(ctx context.Context, in *SetPreferencesRequest, opts ...grpc.CallOption) (*Preferences, error)
+	SubscribeCategory(ctx context.Context, in *CategorySubscriptionRequest, opts ...grpc.CallOption) (*Preferences, error)
+	UnsubscribeCategory(ctx context.Context, in *CategorySubscriptionRequest, opts ...grpc.CallOption) (*Preferences, error)
+	CreateUnsubscribeToken(ctx context.Context, in *CreateUnsubscribeTokenRequest, opts ...grpc.CallOption) (*UnsubscribeToken, error)
 	// Notifications
 	Notify(ctx context.Context, in *NotifyRequest, opts ...grpc.CallOption) (*NotifyResponse, error)
+	BatchNotify(ctx context.Context, in *BatchNotifyRequest, opts ...grpc.CallOption) (*BatchNotifyResponse, error)
+	GetNotification(ctx context.Context, in *GetNotificationRequest, opts ...grpc.CallOption) (*Notification, error)
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*Notifications, error)
+	UpdateRecipientStatus(ctx context.Context, in *UpdateRecipientStatusRequest, opts ...grpc.CallOption) (*RecipientDelivery, error)
+	CancelNotification(ctx context.Context, in *CancelNotificationRequest, opts ...grpc.CallOption) (*Notification, error)
+	WatchNotifications(ctx context.Context, in *WatchNotificationsRequest, opts ...grpc.CallOption) (PersistenceService_WatchNotificationsClient, error)
+	// Templates CRUD
+	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*Template, error)
+	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*Template, error)
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*Templates, error)
+	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*Template, error)
+	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*InfoMessage, error)
+	// User groups
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*Group, error)
+	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*InfoMessage, error)
+	AddGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupMembersResponse, error)
+	RemoveGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupMembersResponse, error)
 }
 
 type persistenceServiceClient struct {
@@ -91,6 +147,60 @@ func (c *persistenceServiceClient) DeleteSystem(ctx context.Context, in *DeleteS
 	return out, nil
 }
 
+func (c *persistenceServiceClient) SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*Quota, error) {
+	out := new(Quota)
+	err := c.cc.Invoke(ctx, PersistenceService_SetQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*Usage, error) {
+	out := new(Usage)
+	err := c.cc.Invoke(ctx, PersistenceService_GetUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
+	out := new(Category)
+	err := c.cc.Invoke(ctx, PersistenceService_CreateCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*Categories, error) {
+	out := new(Categories)
+	err := c.cc.Invoke(ctx, PersistenceService_ListCategories_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
+	out := new(Category)
+	err := c.cc.Invoke(ctx, PersistenceService_UpdateCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*InfoMessage, error) {
+	out := new(InfoMessage)
+	err := c.cc.Invoke(ctx, PersistenceService_DeleteCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *persistenceServiceClient) AddUser(ctx context.Context, in *AddUserRequest, opts ...grpc.CallOption) (*User, error) {
 	out := new(User)
 	err := c.cc.Invoke(ctx, PersistenceService_AddUser_FullMethodName, in, out, opts...)
@@ -127,6 +237,51 @@ func (c *persistenceServiceClient) DeleteUser(ctx context.Context, in *DeleteUse
 	return out, nil
 }
 
+func (c *persistenceServiceClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*Preferences, error) {
+	out := new(Preferences)
+	err := c.cc.Invoke(ctx, PersistenceService_GetPreferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) SetPreferences(ctx context.Context, in *SetPreferencesRequest, opts ...grpc.CallOption) (*Preferences, error) {
+	out := new(Preferences)
+	err := c.cc.Invoke(ctx, PersistenceService_SetPreferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) SubscribeCategory(ctx context.Context, in *CategorySubscriptionRequest, opts ...grpc.CallOption) (*Preferences, error) {
+	out := new(Preferences)
+	err := c.cc.Invoke(ctx, PersistenceService_SubscribeCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) UnsubscribeCategory(ctx context.Context, in *CategorySubscriptionRequest, opts ...grpc.CallOption) (*Preferences, error) {
+	out := new(Preferences)
+	err := c.cc.Invoke(ctx, PersistenceService_UnsubscribeCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) CreateUnsubscribeToken(ctx context.Context, in *CreateUnsubscribeTokenRequest, opts ...grpc.CallOption) (*UnsubscribeToken, error) {
+	out := new(UnsubscribeToken)
+	err := c.cc.Invoke(ctx, PersistenceService_CreateUnsubscribeToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *persistenceServiceClient) Notify(ctx context.Context, in *NotifyRequest, opts ...grpc.CallOption) (*NotifyResponse, error) {
 	out := new(NotifyResponse)
 	err := c.cc.Invoke(ctx, PersistenceService_Notify_FullMethodName, in, out, opts...)
@@ -136,6 +291,164 @@ func (c *persistenceServiceClient) Notify(ctx context.Context, in *NotifyRequest
 	return out, nil
 }
 
+func (c *persistenceServiceClient) BatchNotify(ctx context.Context, in *BatchNotifyRequest, opts ...grpc.CallOption) (*BatchNotifyResponse, error) {
+	out := new(BatchNotifyResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_BatchNotify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) GetNotification(ctx context.Context, in *GetNotificationRequest, opts ...grpc.CallOption) (*Notification, error) {
+	out := new(Notification)
+	err := c.cc.Invoke(ctx, PersistenceService_GetNotification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*Notifications, error) {
+	out := new(Notifications)
+	err := c.cc.Invoke(ctx, PersistenceService_ListNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) UpdateRecipientStatus(ctx context.Context, in *UpdateRecipientStatusRequest, opts ...grpc.CallOption) (*RecipientDelivery, error) {
+	out := new(RecipientDelivery)
+	err := c.cc.Invoke(ctx, PersistenceService_UpdateRecipientStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) CancelNotification(ctx context.Context, in *CancelNotificationRequest, opts ...grpc.CallOption) (*Notification, error) {
+	out := new(Notification)
+	err := c.cc.Invoke(ctx, PersistenceService_CancelNotification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) WatchNotifications(ctx context.Context, in *WatchNotificationsRequest, opts ...grpc.CallOption) (PersistenceService_WatchNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PersistenceService_ServiceDesc.Streams[0], PersistenceService_WatchNotifications_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &persistenceServiceWatchNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PersistenceService_WatchNotificationsClient interface {
+	Recv() (*NotificationEvent, error)
+	grpc.ClientStream
+}
+
+type persistenceServiceWatchNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *persistenceServiceWatchNotificationsClient) Recv() (*NotificationEvent, error) {
+	m := new(NotificationEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *persistenceServiceClient) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*Template, error) {
+	out := new(Template)
+	err := c.cc.Invoke(ctx, PersistenceService_CreateTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*Template, error) {
+	out := new(Template)
+	err := c.cc.Invoke(ctx, PersistenceService_GetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*Templates, error) {
+	out := new(Templates)
+	err := c.cc.Invoke(ctx, PersistenceService_ListTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*Template, error) {
+	out := new(Template)
+	err := c.cc.Invoke(ctx, PersistenceService_UpdateTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*InfoMessage, error) {
+	out := new(InfoMessage)
+	err := c.cc.Invoke(ctx, PersistenceService_DeleteTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	out := new(Group)
+	err := c.cc.Invoke(ctx, PersistenceService_CreateGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*InfoMessage, error) {
+	out := new(InfoMessage)
+	err := c.cc.Invoke(ctx, PersistenceService_DeleteGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) AddGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupMembersResponse, error) {
+	out := new(GroupMembersResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_AddGroupMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *persistenceServiceClient) RemoveGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupMembersResponse, error) {
+	out := new(GroupMembersResponse)
+	err := c.cc.Invoke(ctx, PersistenceService_RemoveGroupMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PersistenceServiceServer is the server API for PersistenceService service.
 // All implementations must embed UnimplementedPersistenceServiceServer
 // for forward compatibility
@@ -144,13 +457,43 @@ type PersistenceServiceServer interface {
 	GetSystems(context.Context, *GetSystemsRequest) (*Systems, error)
 	UpdateSystem(context.Context, *UpdateSystemRequest) (*System, error)
 	DeleteSystem(context.Context, *DeleteSystemRequest) (*InfoMessage, error)
+	// Quotas
+	SetQuota(context.Context, *SetQuotaRequest) (*Quota, error)
+	GetUsage(context.Context, *GetUsageRequest) (*Usage, error)
+	// Notification categories
+	CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*Categories, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*InfoMessage, error)
 	// Users CRUD
 	AddUser(context.Context, *AddUserRequest) (*User, error)
 	GetUsers(context.Context, *GetUsersRequest) (*Users, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*InfoMessage, error)
+	GetPreferences(context.Context, *GetPreferencesRequest) (*Preferences, error)
+	SetPreferences(context.Context, *SetPreferencesRequest) (*Preferences, error)
+	SubscribeCategory(context.Context, *CategorySubscriptionRequest) (*Preferences, error)
+	UnsubscribeCategory(context.Context, *CategorySubscriptionRequest) (*Preferences, error)
+	CreateUnsubscribeToken(context.Context, *CreateUnsubscribeTokenRequest) (*UnsubscribeToken, error)
 	// Notifications
 	Notify(context.Context, *NotifyRequest) (*NotifyResponse, error)
+	BatchNotify(context.Context, *BatchNotifyRequest) (*BatchNotifyResponse, error)
+	GetNotification(context.Context, *GetNotificationRequest) (*Notification, error)
+	ListNotifications(context.Context, *ListNotificationsRequest) (*Notifications, error)
+	UpdateRecipientStatus(context.Context, *UpdateRecipientStatusRequest) (*RecipientDelivery, error)
+	CancelNotification(context.Context, *CancelNotificationRequest) (*Notification, error)
+	WatchNotifications(*WatchNotificationsRequest, PersistenceService_WatchNotificationsServer) error
+	// Templates CRUD
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*Template, error)
+	GetTemplate(context.Context, *GetTemplateRequest) (*Template, error)
+	ListTemplates(context.Context, *ListTemplatesRequest) (*Templates, error)
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*Template, error)
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*InfoMessage, error)
+	// User groups
+	CreateGroup(context.Context, *CreateGroupRequest) (*Group, error)
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*InfoMessage, error)
+	AddGroupMembers(context.Context, *GroupMembersRequest) (*GroupMembersResponse, error)
+	RemoveGroupMembers(context.Context, *GroupMembersRequest) (*GroupMembersResponse, error)
 	mustEmbedUnimplementedPersistenceServiceServer()
 }
 
@@ -170,6 +513,24 @@ func (UnimplementedPersistenceServiceServer) UpdateSystem(context.Context, *Upda
 func (UnimplementedPersistenceServiceServer) DeleteSystem(context.Context, *DeleteSystemRequest) (*InfoMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSystem not implemented")
 }
+func (UnimplementedPersistenceServiceServer) SetQuota(context.Context, *SetQuotaRequest) (*Quota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuota not implemented")
+}
+func (UnimplementedPersistenceServiceServer) GetUsage(context.Context, *GetUsageRequest) (*Usage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedPersistenceServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedPersistenceServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*Categories, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedPersistenceServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedPersistenceServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*InfoMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
 func (UnimplementedPersistenceServiceServer) AddUser(context.Context, *AddUserRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddUser not implemented")
 }
@@ -182,9 +543,69 @@ func (UnimplementedPersistenceServiceServer) UpdateUser(context.Context, *Update
 func (UnimplementedPersistenceServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*InfoMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedPersistenceServiceServer) GetPreferences(context.Context, *GetPreferencesRequest) (*Preferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedPersistenceServiceServer) SetPreferences(context.Context, *SetPreferencesRequest) (*Preferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPreferences not implemented")
+}
+func (UnimplementedPersistenceServiceServer) SubscribeCategory(context.Context, *CategorySubscriptionRequest) (*Preferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeCategory not implemented")
+}
+func (UnimplementedPersistenceServiceServer) UnsubscribeCategory(context.Context, *CategorySubscriptionRequest) (*Preferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeCategory not implemented")
+}
+func (UnimplementedPersistenceServiceServer) CreateUnsubscribeToken(context.Context, *CreateUnsubscribeTokenRequest) (*UnsubscribeToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUnsubscribeToken not implemented")
+}
 func (UnimplementedPersistenceServiceServer) Notify(context.Context, *NotifyRequest) (*NotifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Notify not implemented")
 }
+func (UnimplementedPersistenceServiceServer) BatchNotify(context.Context, *BatchNotifyRequest) (*BatchNotifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchNotify not implemented")
+}
+func (UnimplementedPersistenceServiceServer) GetNotification(context.Context, *GetNotificationRequest) (*Notification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotification not implemented")
+}
+func (UnimplementedPersistenceServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*Notifications, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedPersistenceServiceServer) UpdateRecipientStatus(context.Context, *UpdateRecipientStatusRequest) (*RecipientDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecipientStatus not implemented")
+}
+func (UnimplementedPersistenceServiceServer) CancelNotification(context.Context, *CancelNotificationRequest) (*Notification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelNotification not implemented")
+}
+func (UnimplementedPersistenceServiceServer) WatchNotifications(*WatchNotificationsRequest, PersistenceService_WatchNotificationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNotifications not implemented")
+}
+func (UnimplementedPersistenceServiceServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*Template, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedPersistenceServiceServer) GetTemplate(context.Context, *GetTemplateRequest) (*Template, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedPersistenceServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*Templates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedPersistenceServiceServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*Template, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedPersistenceServiceServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*InfoMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedPersistenceServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedPersistenceServiceServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*InfoMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedPersistenceServiceServer) AddGroupMembers(context.Context, *GroupMembersRequest) (*GroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupMembers not implemented")
+}
+func (UnimplementedPersistenceServiceServer) RemoveGroupMembers(context.Context, *GroupMembersRequest) (*GroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupMembers not implemented")
+}
 func (UnimplementedPersistenceServiceServer) mustEmbedUnimplementedPersistenceServiceServer() {}
 
 // UnsafePersistenceServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -270,140 +691,717 @@ func _PersistenceService_DeleteSystem_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PersistenceService_AddUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddUserRequest)
+func _PersistenceService_SetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuotaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PersistenceServiceServer).AddUser(ctx, in)
+		return srv.(PersistenceServiceServer).SetQuota(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PersistenceService_AddUser_FullMethodName,
+		FullMethod: PersistenceService_SetQuota_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PersistenceServiceServer).AddUser(ctx, req.(*AddUserRequest))
+		return srv.(PersistenceServiceServer).SetQuota(ctx, req.(*SetQuotaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PersistenceService_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUsersRequest)
+func _PersistenceService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PersistenceServiceServer).GetUsers(ctx, in)
+		return srv.(PersistenceServiceServer).GetUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PersistenceService_GetUsers_FullMethodName,
+		FullMethod: PersistenceService_GetUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PersistenceServiceServer).GetUsers(ctx, req.(*GetUsersRequest))
+		return srv.(PersistenceServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PersistenceService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _PersistenceService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PersistenceServiceServer).UpdateUser(ctx, in)
+		return srv.(PersistenceServiceServer).CreateCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PersistenceService_UpdateUser_FullMethodName,
+		FullMethod: PersistenceService_CreateCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PersistenceServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(PersistenceServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PersistenceService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _PersistenceService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PersistenceServiceServer).DeleteUser(ctx, in)
+		return srv.(PersistenceServiceServer).ListCategories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PersistenceService_DeleteUser_FullMethodName,
+		FullMethod: PersistenceService_ListCategories_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PersistenceServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+		return srv.(PersistenceServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PersistenceService_Notify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NotifyRequest)
+func _PersistenceService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PersistenceServiceServer).Notify(ctx, in)
+		return srv.(PersistenceServiceServer).UpdateCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PersistenceService_Notify_FullMethodName,
+		FullMethod: PersistenceService_UpdateCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PersistenceServiceServer).Notify(ctx, req.(*NotifyRequest))
+		return srv.(PersistenceServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// PersistenceService_ServiceDesc is the grpc.ServiceDesc for PersistenceService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var PersistenceService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "persistence.v1.PersistenceService",
-	HandlerType: (*PersistenceServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateSystem",
-			Handler:    _PersistenceService_CreateSystem_Handler,
-		},
-		{
-			MethodName: "GetSystems",
-			Handler:    _PersistenceService_GetSystems_Handler,
-		},
-		{
-			MethodName: "UpdateSystem",
-			Handler:    _PersistenceService_UpdateSystem_Handler,
-		},
-		{
-			MethodName: "DeleteSystem",
-			Handler:    _PersistenceService_DeleteSystem_Handler,
-		},
-		{
-			MethodName: "AddUser",
-			Handler:    _PersistenceService_AddUser_Handler,
-		},
-		{
-			MethodName: "GetUsers",
-			Handler:    _PersistenceService_GetUsers_Handler,
-		},
-		{
-			MethodName: "UpdateUser",
-			Handler:    _PersistenceService_UpdateUser_Handler,
-		},
+func _PersistenceService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_AddUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).AddUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_AddUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).AddUser(ctx, req.(*AddUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).GetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_GetUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).GetUsers(ctx, req.(*GetUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_GetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).GetPreferences(ctx, req.(*GetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_SetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).SetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_SetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).SetPreferences(ctx, req.(*SetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_SubscribeCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategorySubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).SubscribeCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_SubscribeCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).SubscribeCategory(ctx, req.(*CategorySubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_UnsubscribeCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategorySubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).UnsubscribeCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_UnsubscribeCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).UnsubscribeCategory(ctx, req.(*CategorySubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_CreateUnsubscribeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUnsubscribeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).CreateUnsubscribeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_CreateUnsubscribeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).CreateUnsubscribeToken(ctx, req.(*CreateUnsubscribeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_Notify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).Notify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_Notify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).Notify(ctx, req.(*NotifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_BatchNotify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchNotifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).BatchNotify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_BatchNotify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).BatchNotify(ctx, req.(*BatchNotifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_GetNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).GetNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_GetNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).GetNotification(ctx, req.(*GetNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_UpdateRecipientStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecipientStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).UpdateRecipientStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_UpdateRecipientStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).UpdateRecipientStatus(ctx, req.(*UpdateRecipientStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_CancelNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).CancelNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_CancelNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).CancelNotification(ctx, req.(*CancelNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_WatchNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PersistenceServiceServer).WatchNotifications(m, &persistenceServiceWatchNotificationsServer{stream})
+}
+
+type PersistenceService_WatchNotificationsServer interface {
+	Send(*NotificationEvent) error
+	grpc.ServerStream
+}
+
+type persistenceServiceWatchNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *persistenceServiceWatchNotificationsServer) Send(m *NotificationEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PersistenceService_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_CreateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_GetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).GetTemplate(ctx, req.(*GetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).UpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_UpdateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_DeleteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_AddGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).AddGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_AddGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).AddGroupMembers(ctx, req.(*GroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersistenceService_RemoveGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersistenceServiceServer).RemoveGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersistenceService_RemoveGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersistenceServiceServer).RemoveGroupMembers(ctx, req.(*GroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PersistenceService_ServiceDesc is the grpc.ServiceDesc for PersistenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PersistenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "persistence.v1.PersistenceService",
+	HandlerType: (*PersistenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSystem",
+			Handler:    _PersistenceService_CreateSystem_Handler,
+		},
+		{
+			MethodName: "GetSystems",
+			Handler:    _PersistenceService_GetSystems_Handler,
+		},
+		{
+			MethodName: "UpdateSystem",
+			Handler:    _PersistenceService_UpdateSystem_Handler,
+		},
+		{
+			MethodName: "DeleteSystem",
+			Handler:    _PersistenceService_DeleteSystem_Handler,
+		},
+		{
+			MethodName: "SetQuota",
+			Handler:    _PersistenceService_SetQuota_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _PersistenceService_GetUsage_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _PersistenceService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _PersistenceService_ListCategories_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _PersistenceService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _PersistenceService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "AddUser",
+			Handler:    _PersistenceService_AddUser_Handler,
+		},
+		{
+			MethodName: "GetUsers",
+			Handler:    _PersistenceService_GetUsers_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _PersistenceService_UpdateUser_Handler,
+		},
 		{
 			MethodName: "DeleteUser",
 			Handler:    _PersistenceService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _PersistenceService_GetPreferences_Handler,
+		},
+		{
+			MethodName: "SetPreferences",
+			Handler:    _PersistenceService_SetPreferences_Handler,
+		},
+		{
+			MethodName: "SubscribeCategory",
+			Handler:    _PersistenceService_SubscribeCategory_Handler,
+		},
+		{
+			MethodName: "UnsubscribeCategory",
+			Handler:    _PersistenceService_UnsubscribeCategory_Handler,
+		},
+		{
+			MethodName: "CreateUnsubscribeToken",
+			Handler:    _PersistenceService_CreateUnsubscribeToken_Handler,
+		},
 		{
 			MethodName: "Notify",
 			Handler:    _PersistenceService_Notify_Handler,
 		},
+		{
+			MethodName: "BatchNotify",
+			Handler:    _PersistenceService_BatchNotify_Handler,
+		},
+		{
+			MethodName: "GetNotification",
+			Handler:    _PersistenceService_GetNotification_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _PersistenceService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "UpdateRecipientStatus",
+			Handler:    _PersistenceService_UpdateRecipientStatus_Handler,
+		},
+		{
+			MethodName: "CancelNotification",
+			Handler:    _PersistenceService_CancelNotification_Handler,
+		},
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _PersistenceService_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _PersistenceService_GetTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _PersistenceService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _PersistenceService_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _PersistenceService_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _PersistenceService_CreateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _PersistenceService_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "AddGroupMembers",
+			Handler:    _PersistenceService_AddGroupMembers_Handler,
+		},
+		{
+			MethodName: "RemoveGroupMembers",
+			Handler:    _PersistenceService_RemoveGroupMembers_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchNotifications",
+			Handler:       _PersistenceService_WatchNotifications_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "persistence/v1/service.proto",
 }